@@ -1,23 +1,53 @@
 package internal
 
-import "github.com/restechnica/semverbot/pkg/modes"
+import (
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
 
 const (
+	// DefaultCIGitLabDotenv the default relative filepath to the GitLab CI dotenv report.
+	DefaultCIGitLabDotenv = "sbot.env"
+
 	// DefaultConfigFilePath the default relative filepath to the config file.
 	DefaultConfigFilePath = ".semverbot.toml"
 
+	// DefaultGitBackend the default backend used to interact with git.
+	DefaultGitBackend = "cli"
+
 	// DefaultGitBranchDelimiters the default delimiters used by the git-branch mode.
 	DefaultGitBranchDelimiters = "/"
 
 	// DefaultGitCommitDelimiters the default delimiters used by the git-commit mode.
 	DefaultGitCommitDelimiters = "[]/"
 
+	// DefaultGitConfigEmail the default git user.email for new configs.
+	DefaultGitConfigEmail = "semverbot@github.com"
+
+	// DefaultGitConfigName the default git user.name for new configs.
+	DefaultGitConfigName = "semverbot"
+
+	// DefaultGitPushRetries the default number of times a release is retried when a remote rejects the pushed tag.
+	DefaultGitPushRetries = 3
+
+	// DefaultGitRef the default ref to predict and release versions for.
+	DefaultGitRef = "HEAD"
+
+	// DefaultGitRemote the default remote to fetch tags from and push tags to.
+	DefaultGitRemote = "origin"
+
+	// DefaultGitTagsMerged the default for new configs whether to only consider tags merged into the ref.
+	DefaultGitTagsMerged = true
+
 	// DefaultGitTagsPrefix the default prefix prepended to git tags.
 	DefaultGitTagsPrefix = "v"
 
 	// DefaultGitTagsSuffix the default prefix prepended to git tags.
 	DefaultGitTagsSuffix = ""
 
+	// DefaultGitTagsTemplate the default template to render versions into git tags.
+	DefaultGitTagsTemplate = semver.DefaultTemplate
+
 	// DefaultMode the default mode for incrementing versions.
 	DefaultMode = modes.Auto
 
@@ -1,42 +1,119 @@
 package fakes
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+
+	"github.com/restechnica/semverbot/internal/util"
+	"github.com/restechnica/semverbot/pkg/git"
+)
 
 // FakeGitAPI a git.API interface fake implementation.
+// RemoteTags are the tags which already exist on each fake remote, e.g. released by another pipeline.
+// UnmergedTags are the LocalTags which are not merged into any ref, e.g. released on another branch.
+// RefTags are the LocalTags merged into a specific ref, instead of all tags but the UnmergedTags.
+// Fetched RemoteTags are unmerged as well.
 type FakeGitAPI struct {
-	Config     map[string]string
-	LocalTags  []string
-	PushedTags []string
+	Config         map[string]string
+	FetchedRemotes []string
+	LocalTags      []string
+	PushedRemotes  []string
+	PushedTags     []string
+	RefTags        map[string][]string
+	RemoteTags     map[string][]string
+	SignedTags     []string
+	Unshallowed    bool
+	UnmergedTags   []string
 }
 
 // NewFakeGitAPI creates a new FakeGitAPI.
 // Returns the new FakeGitAPI.
 func NewFakeGitAPI() *FakeGitAPI {
 	return &FakeGitAPI{
-		Config:     map[string]string{},
-		LocalTags:  []string{},
-		PushedTags: []string{},
+		Config:         map[string]string{},
+		FetchedRemotes: []string{},
+		LocalTags:      []string{},
+		PushedRemotes:  []string{},
+		PushedTags:     []string{},
+		RefTags:        map[string][]string{},
+		RemoteTags:     map[string][]string{},
+		SignedTags:     []string{},
+		UnmergedTags:   []string{},
 	}
 }
 
 // CreateAnnotatedTag creates a fake tag.
-func (fake *FakeGitAPI) CreateAnnotatedTag(tag string) (err error) {
+func (fake *FakeGitAPI) CreateAnnotatedTag(ctx context.Context, tag string, ref string, message string) (err error) {
+	fake.LocalTags = append(fake.LocalTags, tag)
+	return err
+}
+
+// CreateSignedTag creates a fake signed tag.
+func (fake *FakeGitAPI) CreateSignedTag(ctx context.Context, tag string, ref string, signingKey string, signingFormat string) (err error) {
 	fake.LocalTags = append(fake.LocalTags, tag)
+	fake.SignedTags = append(fake.SignedTags, tag)
 	return err
 }
 
-// FetchTags does nothing.
-func (fake *FakeGitAPI) FetchTags() (output string, err error) {
+// DeleteRemoteTag deletes a fake tag from the fake remotes.
+// Returns an error if the tag was not pushed.
+func (fake *FakeGitAPI) DeleteRemoteTag(ctx context.Context, remote string, tag string) (err error) {
+	for i, pushed := range fake.PushedTags {
+		if pushed == tag && fake.PushedRemotes[i] == remote {
+			fake.PushedRemotes = append(fake.PushedRemotes[:i], fake.PushedRemotes[i+1:]...)
+			fake.PushedTags = append(fake.PushedTags[:i], fake.PushedTags[i+1:]...)
+			return err
+		}
+	}
+
+	return fmt.Errorf("tag '%s' not found on remote '%s'", tag, remote)
+}
+
+// DeleteTag deletes a fake tag.
+// Returns an error if the tag does not exist.
+func (fake *FakeGitAPI) DeleteTag(ctx context.Context, tag string) (err error) {
+	for i, local := range fake.LocalTags {
+		if local == tag {
+			fake.LocalTags = append(fake.LocalTags[:i], fake.LocalTags[i+1:]...)
+			return err
+		}
+	}
+
+	return fmt.Errorf("tag '%s' not found", tag)
+}
+
+// FetchTags fetches the fake tags of a fake remote which do not exist locally yet.
+func (fake *FakeGitAPI) FetchTags(ctx context.Context, remote string) (output string, err error) {
+	fake.FetchedRemotes = append(fake.FetchedRemotes, remote)
+
+	for _, tag := range fake.RemoteTags[remote] {
+		if !util.SliceContainsString(fake.LocalTags, tag) {
+			fake.LocalTags = append(fake.LocalTags, tag)
+			fake.UnmergedTags = append(fake.UnmergedTags, tag)
+		}
+	}
+
 	return output, err
 }
 
-// FetchUnshallow does nothing.
-func (fake *FakeGitAPI) FetchUnshallow() (output string, err error) {
+// FetchUnshallow records that the fake repository was made unshallow.
+func (fake *FakeGitAPI) FetchUnshallow(ctx context.Context) (output string, err error) {
+	fake.Unshallowed = true
 	return output, err
 }
 
+// GetBranchName does nothing.
+func (fake *FakeGitAPI) GetBranchName(ctx context.Context, ref string) (name string, err error) {
+	return name, err
+}
+
+// GetCommitHash does nothing.
+func (fake *FakeGitAPI) GetCommitHash(ctx context.Context, ref string) (hash string, err error) {
+	return hash, err
+}
+
 // GetConfig returns a fake config.
-func (fake *FakeGitAPI) GetConfig(key string) (value string, err error) {
+func (fake *FakeGitAPI) GetConfig(ctx context.Context, key string) (value string, err error) {
 	var config, exists = fake.Config[key]
 
 	if exists {
@@ -47,7 +124,7 @@ func (fake *FakeGitAPI) GetConfig(key string) (value string, err error) {
 }
 
 // GetLatestAnnotatedTag returns a fake tag.
-func (fake *FakeGitAPI) GetLatestAnnotatedTag() (tag string, err error) {
+func (fake *FakeGitAPI) GetLatestAnnotatedTag(ctx context.Context) (tag string, err error) {
 	if len(fake.LocalTags) == 0 {
 		return tag, fmt.Errorf("no tags found")
 	}
@@ -55,38 +132,78 @@ func (fake *FakeGitAPI) GetLatestAnnotatedTag() (tag string, err error) {
 }
 
 // GetLatestCommitMessage does nothing.
-func (fake *FakeGitAPI) GetLatestCommitMessage() (message string, err error) {
+func (fake *FakeGitAPI) GetLatestCommitMessage(ctx context.Context, ref string) (message string, err error) {
 	return message, err
 }
 
 // GetMergedBranchName does nothing.
-func (fake *FakeGitAPI) GetMergedBranchName() (name string, err error) {
+func (fake *FakeGitAPI) GetMergedBranchName(ctx context.Context, ref string) (name string, err error) {
 	return name, err
 }
 
-// GetTags does nothing
-func (fake *FakeGitAPI) GetTags() (tags string, err error) {
+// GetMergedTags returns the fake tags merged into a ref, or else the fake tags which are not unmerged, one per line.
+func (fake *FakeGitAPI) GetMergedTags(ctx context.Context, ref string) (tags string, err error) {
+	var refTags, found = fake.RefTags[ref]
+
+	for _, tag := range fake.LocalTags {
+		if found && util.SliceContainsString(refTags, tag) || !found && !util.SliceContainsString(fake.UnmergedTags, tag) {
+			tags += tag + "\n"
+		}
+	}
+
+	return tags, err
+}
+
+// GetTagDetails does nothing.
+func (fake *FakeGitAPI) GetTagDetails(ctx context.Context) (details string, err error) {
+	return details, err
+}
+
+// GetTags returns the fake tags, one per line.
+func (fake *FakeGitAPI) GetTags(ctx context.Context) (tags string, err error) {
+	for _, tag := range fake.LocalTags {
+		tags += tag + "\n"
+	}
+
 	return tags, err
 }
 
-// PushTag pushes a fake tag.
-func (fake *FakeGitAPI) PushTag(tag string) (err error) {
+// PushTag pushes a fake tag to a fake remote.
+// Returns a git.RejectedError if the tag already exists on the fake remote.
+func (fake *FakeGitAPI) PushTag(ctx context.Context, remote string, tag string) (err error) {
+	if util.SliceContainsString(fake.RemoteTags[remote], tag) {
+		return git.NewRejectedError(remote, tag, fmt.Errorf("[rejected] %s (already exists)", tag))
+	}
+
+	fake.PushedRemotes = append(fake.PushedRemotes, remote)
 	fake.PushedTags = append(fake.PushedTags, tag)
 	return err
 }
 
 // SetConfig sets a fake config.
-func (fake *FakeGitAPI) SetConfig(key string, value string) (err error) {
+func (fake *FakeGitAPI) SetConfig(ctx context.Context, key string, value string) (err error) {
 	fake.Config[key] = value
 	return err
 }
 
 // SetConfigIfNotSet sets a fake config if it does not exist.
-func (fake *FakeGitAPI) SetConfigIfNotSet(key string, value string) (actual string, err error) {
-	if actual, err = fake.GetConfig(key); err != nil {
-		err = fake.SetConfig(key, value)
+func (fake *FakeGitAPI) SetConfigIfNotSet(ctx context.Context, key string, value string) (actual string, err error) {
+	if actual, err = fake.GetConfig(ctx, key); err != nil {
+		err = fake.SetConfig(ctx, key, value)
 		actual = value
 	}
 
 	return actual, err
 }
+
+// VerifyTag verifies a fake tag.
+// Returns an error if the tag was not signed.
+func (fake *FakeGitAPI) VerifyTag(ctx context.Context, tag string) (output string, err error) {
+	for _, signed := range fake.SignedTags {
+		if signed == tag {
+			return output, err
+		}
+	}
+
+	return output, fmt.Errorf("no signature found")
+}
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+// InitConfig the config properties which are written by sbot init.
+type InitConfig struct {
+	GitBackend               string
+	GitConfigEmail           string
+	GitConfigName            string
+	GitRemote                string
+	GitTagsMerged            bool
+	GitTagsPrefix            string
+	GitTagsSuffix            string
+	GitTagsTemplate          string
+	Mode                     string
+	ModesGitBranchDelimiters string
+	ModesGitCommitDelimiters string
+	SemverMap                semver.Map
+}
+
+// String returns the TOML representation of an instance, with the semver levels in increasing order.
+func (config InitConfig) String() string {
+	const template = `mode = %q
+
+[git]
+backend = %q
+remote = %q
+
+[git.config]
+email = %q
+name = %q
+
+[git.tags]
+merged = %t
+prefix = %q
+suffix = %q
+template = %q
+
+[semver]
+patch = %s
+minor = %s
+major = %s
+
+[modes]
+
+[modes.git-branch]
+delimiters = %q
+
+[modes.git-commit]
+delimiters = %q
+`
+
+	var formatKeywords = func(keywords []string) string {
+		var quoted = make([]string, len(keywords))
+
+		for i, keyword := range keywords {
+			quoted[i] = fmt.Sprintf("%q", keyword)
+		}
+
+		return "[" + strings.Join(quoted, ", ") + "]"
+	}
+
+	return fmt.Sprintf(
+		template,
+		config.Mode,
+		config.GitBackend,
+		config.GitRemote,
+		config.GitConfigEmail,
+		config.GitConfigName,
+		config.GitTagsMerged,
+		config.GitTagsPrefix,
+		config.GitTagsSuffix,
+		config.GitTagsTemplate,
+		formatKeywords(config.SemverMap[modes.Patch]),
+		formatKeywords(config.SemverMap[modes.Minor]),
+		formatKeywords(config.SemverMap[modes.Major]),
+		config.ModesGitBranchDelimiters,
+		config.ModesGitCommitDelimiters,
+	)
+}
+
+// DefaultInitConfig gets the config which sbot init starts from.
+// Returns the default init config.
+func DefaultInitConfig() InitConfig {
+	return InitConfig{
+		GitBackend:               DefaultGitBackend,
+		GitConfigEmail:           DefaultGitConfigEmail,
+		GitConfigName:            DefaultGitConfigName,
+		GitRemote:                DefaultGitRemote,
+		GitTagsMerged:            DefaultGitTagsMerged,
+		GitTagsPrefix:            DefaultGitTagsPrefix,
+		GitTagsSuffix:            DefaultGitTagsSuffix,
+		GitTagsTemplate:          DefaultGitTagsTemplate,
+		Mode:                     DefaultMode,
+		ModesGitBranchDelimiters: DefaultGitBranchDelimiters,
+		ModesGitCommitDelimiters: DefaultGitCommitDelimiters,
+		SemverMap: semver.Map{
+			modes.Patch: {"fix", "bug"},
+			modes.Minor: {"feature"},
+			modes.Major: {"release"},
+		},
+	}
+}
@@ -1,8 +1,12 @@
 package mocks
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
 
-// MockCommander a commander interface mock implementation.
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCommander a git.Commander interface mock implementation.
 type MockCommander struct {
 	mock.Mock
 }
@@ -15,14 +19,14 @@ func NewMockCommander() *MockCommander {
 
 // Output runs a mock command.
 // Returns mocked output or a mocked error.
-func (mock *MockCommander) Output(name string, arg ...string) (string, error) {
+func (mock *MockCommander) Output(_ context.Context, name string, arg ...string) (string, error) {
 	args := mock.Called(name, arg)
 	return args.String(0), args.Error(1)
 }
 
 // Run runs a mock command.
 // Returns a mocked error.
-func (mock *MockCommander) Run(name string, arg ...string) error {
+func (mock *MockCommander) Run(_ context.Context, name string, arg ...string) error {
 	args := mock.Called(name, arg)
 	return args.Error(0)
 }
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,77 +19,133 @@ func NewMockGitAPI() *MockGitAPI {
 
 // CreateAnnotatedTag mocks creating a tag.
 // Returns a mocked error.
-func (mock *MockGitAPI) CreateAnnotatedTag(tag string) (err error) {
+func (mock *MockGitAPI) CreateAnnotatedTag(_ context.Context, tag string, ref string, message string) (err error) {
+	args := mock.Called(tag, ref, message)
+	return args.Error(0)
+}
+
+// CreateSignedTag mocks creating a signed tag.
+// Returns a mocked error.
+func (mock *MockGitAPI) CreateSignedTag(_ context.Context, tag string, ref string, signingKey string, signingFormat string) (err error) {
+	args := mock.Called(tag, ref, signingKey, signingFormat)
+	return args.Error(0)
+}
+
+// DeleteRemoteTag mocks deleting a tag on a remote.
+// Returns a mocked error.
+func (mock *MockGitAPI) DeleteRemoteTag(_ context.Context, remote string, tag string) (err error) {
+	args := mock.Called(remote, tag)
+	return args.Error(0)
+}
+
+// DeleteTag mocks deleting a tag.
+// Returns a mocked error.
+func (mock *MockGitAPI) DeleteTag(_ context.Context, tag string) (err error) {
 	args := mock.Called(tag)
 	return args.Error(0)
 }
 
 // FetchTags mocks fetching tags.
 // Returns a mocked error.
-func (mock *MockGitAPI) FetchTags() (output string, err error) {
-	args := mock.Called()
+func (mock *MockGitAPI) FetchTags(_ context.Context, remote string) (output string, err error) {
+	args := mock.Called(remote)
 	return args.String(0), args.Error(1)
 }
 
 // FetchUnshallow mocks changing to an unshallow repo.
 // Returns a mocked error.
-func (mock *MockGitAPI) FetchUnshallow() (output string, err error) {
+func (mock *MockGitAPI) FetchUnshallow(_ context.Context) (output string, err error) {
 	args := mock.Called()
 	return args.String(0), args.Error(0)
 }
 
+// GetBranchName mocks getting the short name of a ref.
+// Returns a mocked name or a mocked error.
+func (mock *MockGitAPI) GetBranchName(_ context.Context, ref string) (name string, err error) {
+	args := mock.Called(ref)
+	return args.String(0), args.Error(1)
+}
+
+// GetCommitHash mocks getting the commit hash of a ref.
+// Returns a mocked commit hash or a mocked error.
+func (mock *MockGitAPI) GetCommitHash(_ context.Context, ref string) (hash string, err error) {
+	args := mock.Called(ref)
+	return args.String(0), args.Error(1)
+}
+
 // GetConfig mocks getting a config.
 // Returns a mocked config or a mocked error.
-func (mock *MockGitAPI) GetConfig(key string) (value string, err error) {
+func (mock *MockGitAPI) GetConfig(_ context.Context, key string) (value string, err error) {
 	args := mock.Called(key)
 	return args.String(0), args.Error(1)
 }
 
 // GetLatestAnnotatedTag mocks getting the latest annotated tag.
 // Returns a mocked tag or a mocked error.
-func (mock *MockGitAPI) GetLatestAnnotatedTag() (tag string, err error) {
+func (mock *MockGitAPI) GetLatestAnnotatedTag(_ context.Context) (tag string, err error) {
 	args := mock.Called()
 	return args.String(0), args.Error(1)
 }
 
-// GetLatestCommitMessage mocks getting the latest commit message.
+// GetLatestCommitMessage mocks getting the commit message of a ref.
 // Returns a mocked commit message or a mocked error.
-func (mock *MockGitAPI) GetLatestCommitMessage() (message string, err error) {
-	args := mock.Called()
+func (mock *MockGitAPI) GetLatestCommitMessage(_ context.Context, ref string) (message string, err error) {
+	args := mock.Called(ref)
 	return args.String(0), args.Error(1)
 }
 
 // GetMergedBranchName mocks getting a merged branch name.
 // Returns a mocked merged branch name or a mocked error.
-func (mock *MockGitAPI) GetMergedBranchName() (name string, err error) {
+func (mock *MockGitAPI) GetMergedBranchName(_ context.Context, ref string) (name string, err error) {
+	args := mock.Called(ref)
+	return args.String(0), args.Error(1)
+}
+
+// GetMergedTags mocks getting all tags merged into a ref.
+// Returns a mocked string of tags or a mocked error.
+func (mock *MockGitAPI) GetMergedTags(_ context.Context, ref string) (tags string, err error) {
+	args := mock.Called(ref)
+	return args.String(0), args.Error(1)
+}
+
+// GetTagDetails mocks getting all tags with their details.
+// Returns a mocked string of tag details or a mocked error.
+func (mock *MockGitAPI) GetTagDetails(_ context.Context) (details string, err error) {
 	args := mock.Called()
 	return args.String(0), args.Error(1)
 }
 
 // GetTags mocks getting all tags.
 // Returns a mocked string of tags or a mocked error.
-func (mock *MockGitAPI) GetTags() (tags string, err error) {
+func (mock *MockGitAPI) GetTags(_ context.Context) (tags string, err error) {
 	args := mock.Called()
 	return args.String(0), args.Error(1)
 }
 
 // PushTag pushes a fake tag.
 // Returns a mocked error.
-func (mock *MockGitAPI) PushTag(tag string) (err error) {
-	args := mock.Called(tag)
+func (mock *MockGitAPI) PushTag(_ context.Context, remote string, tag string) (err error) {
+	args := mock.Called(remote, tag)
 	return args.Error(0)
 }
 
 // SetConfig mocks setting a config.
 // Returns a mocked error.
-func (mock *MockGitAPI) SetConfig(key string, value string) (err error) {
+func (mock *MockGitAPI) SetConfig(_ context.Context, key string, value string) (err error) {
 	args := mock.Called(key, value)
 	return args.Error(0)
 }
 
 // SetConfigIfNotSet mocks setting a config if not set.
 // Returns a mocked error.
-func (mock *MockGitAPI) SetConfigIfNotSet(key string, value string) (actual string, err error) {
+func (mock *MockGitAPI) SetConfigIfNotSet(_ context.Context, key string, value string) (actual string, err error) {
 	args := mock.Called(key, value)
 	return args.String(0), args.Error(1)
 }
+
+// VerifyTag mocks verifying a tag.
+// Returns a mocked output or a mocked error.
+func (mock *MockGitAPI) VerifyTag(_ context.Context, tag string) (output string, err error) {
+	args := mock.Called(tag)
+	return args.String(0), args.Error(1)
+}
@@ -1,6 +1,10 @@
 package mocks
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
 
 // MockMode a semver mode interface mock implementation.
 type MockMode struct {
@@ -15,7 +19,7 @@ func NewMockMode() *MockMode {
 
 // Increment mock increments a version.
 // Returns an incremented mock version.
-func (mock *MockMode) Increment(_ string, _ string, targetVersion string) (nextVersion string, err error) {
+func (mock *MockMode) Increment(_ context.Context, targetVersion string) (nextVersion string, err error) {
 	args := mock.Called(targetVersion)
 	return args.String(0), args.Error(1)
 }
@@ -0,0 +1,64 @@
+package ci
+
+const (
+	// GitHubActions the name of the GitHub Actions platform.
+	GitHubActions = "github-actions"
+
+	// GitLabCI the name of the GitLab CI platform.
+	GitLabCI = "gitlab-ci"
+)
+
+// Outputs the version information written for a CI platform. Empty fields are not written.
+type Outputs struct {
+	Version         string
+	PreviousVersion string
+	Level           string
+	Tag             string
+}
+
+// Options options for the outputs of the CI platforms.
+type Options struct {
+	GitHubStepSummary bool
+	GitLabDotenv      string
+}
+
+// Platform interface which writes outputs for a CI platform, to be used by later steps or jobs.
+type Platform interface {
+	WriteOutputs(outputs Outputs) error
+	String() string
+}
+
+// Detect detects the CI platform sbot runs on based on environment variables, which are looked up with getenv, e.g. os.Getenv.
+// Returns the detected platform or nil if sbot does not run on a supported CI platform.
+func Detect(getenv func(key string) string, options Options) Platform {
+	if getenv("GITHUB_ACTIONS") == "true" {
+		var platform = NewGitHubActionsPlatform(getenv("GITHUB_OUTPUT"), "")
+
+		if options.GitHubStepSummary {
+			platform.StepSummaryPath = getenv("GITHUB_STEP_SUMMARY")
+		}
+
+		return platform
+	}
+
+	if getenv("GITLAB_CI") == "true" {
+		return NewGitLabCIPlatform(options.GitLabDotenv)
+	}
+
+	return nil
+}
+
+// DetectBranch detects the branch a CI pipeline runs for based on environment variables, which are looked up with getenv,
+// e.g. os.Getenv. CI platforms usually check out a detached HEAD, which has no branch name of its own.
+// Returns the branch or an empty string if no branch is detected, e.g. for tag pipelines.
+func DetectBranch(getenv func(key string) string) string {
+	if getenv("GITHUB_ACTIONS") == "true" && getenv("GITHUB_REF_TYPE") == "branch" {
+		return getenv("GITHUB_REF_NAME")
+	}
+
+	if getenv("GITLAB_CI") == "true" {
+		return getenv("CI_COMMIT_BRANCH")
+	}
+
+	return ""
+}
@@ -0,0 +1,82 @@
+package ci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	type Test struct {
+		Env     map[string]string
+		Name    string
+		Options Options
+		Want    Platform
+	}
+
+	var tests = []Test{
+		{Name: "DetectNothingOutsideCI", Env: map[string]string{}, Want: nil},
+		{
+			Name: "DetectGitHubActions",
+			Env:  map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_OUTPUT": "output", "GITHUB_STEP_SUMMARY": "summary"},
+			Want: NewGitHubActionsPlatform("output", ""),
+		},
+		{
+			Name:    "DetectGitHubActionsWithStepSummary",
+			Env:     map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_OUTPUT": "output", "GITHUB_STEP_SUMMARY": "summary"},
+			Options: Options{GitHubStepSummary: true},
+			Want:    NewGitHubActionsPlatform("output", "summary"),
+		},
+		{
+			Name:    "DetectGitLabCI",
+			Env:     map[string]string{"GITLAB_CI": "true"},
+			Options: Options{GitLabDotenv: "sbot.env"},
+			Want:    NewGitLabCIPlatform("sbot.env"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var getenv = func(key string) string { return test.Env[key] }
+			var got = Detect(getenv, test.Options)
+
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
+
+func TestDetectBranch(t *testing.T) {
+	type Test struct {
+		Env  map[string]string
+		Name string
+		Want string
+	}
+
+	var tests = []Test{
+		{Name: "DetectNothingOutsideCI", Env: map[string]string{"CI_COMMIT_BRANCH": "main"}, Want: ""},
+		{
+			Name: "DetectGitHubActionsBranch",
+			Env:  map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_REF_TYPE": "branch", "GITHUB_REF_NAME": "release/1.4.x"},
+			Want: "release/1.4.x",
+		},
+		{
+			Name: "DetectNothingForGitHubActionsTag",
+			Env:  map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_REF_TYPE": "tag", "GITHUB_REF_NAME": "v1.4.0"},
+			Want: "",
+		},
+		{
+			Name: "DetectGitLabCIBranch",
+			Env:  map[string]string{"GITLAB_CI": "true", "CI_COMMIT_BRANCH": "release/1.4.x"},
+			Want: "release/1.4.x",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var getenv = func(key string) string { return test.Env[key] }
+			var got = DetectBranch(getenv)
+
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package ci
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// GitHubActionsPlatform a Platform which writes step outputs and, optionally, a step summary.
+type GitHubActionsPlatform struct {
+	OutputPath      string
+	StepSummaryPath string
+}
+
+// NewGitHubActionsPlatform creates a new GitHubActionsPlatform.
+// Nothing is written to an empty path.
+// Returns the new GitHubActionsPlatform.
+func NewGitHubActionsPlatform(outputPath string, stepSummaryPath string) GitHubActionsPlatform {
+	return GitHubActionsPlatform{OutputPath: outputPath, StepSummaryPath: stepSummaryPath}
+}
+
+// WriteOutputs appends the outputs as version, previous-version, level and tag to the step outputs file.
+// A table with the outputs is appended to the step summary file.
+// Returns an error if any of the files could not be written.
+func (platform GitHubActionsPlatform) WriteOutputs(outputs Outputs) (err error) {
+	if platform.OutputPath != "" {
+		var lines strings.Builder
+
+		for _, output := range [][2]string{
+			{"version", outputs.Version},
+			{"previous-version", outputs.PreviousVersion},
+			{"level", outputs.Level},
+			{"tag", outputs.Tag},
+		} {
+			if output[1] != "" {
+				fmt.Fprintf(&lines, "%s=%s\n", output[0], output[1])
+			}
+		}
+
+		if err = appendFile(platform.OutputPath, lines.String()); err != nil {
+			return err
+		}
+	}
+
+	if platform.StepSummaryPath != "" {
+		var summary = fmt.Sprintf(
+			"### sbot version %s\n\n| previous version | level | tag |\n| --- | --- | --- |\n| %s | %s | %s |\n",
+			outputs.Version,
+			outputs.PreviousVersion,
+			outputs.Level,
+			outputs.Tag,
+		)
+
+		return appendFile(platform.StepSummaryPath, summary)
+	}
+
+	return err
+}
+
+// String returns a string representation of an instance.
+func (platform GitHubActionsPlatform) String() string {
+	return GitHubActions
+}
+
+// appendFile appends content to a file, which is created if it does not exist.
+// Returns an error if the file could not be written.
+func appendFile(path string, content string) (err error) {
+	var file *os.File
+
+	if file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644); err != nil {
+		return fmt.Errorf("failed to open '%s': %w", path, err)
+	}
+
+	if _, err = file.WriteString(content); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write '%s': %w", path, err)
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,58 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHubActionsPlatform_WriteOutputs(t *testing.T) {
+	t.Run("AppendOutputs", func(t *testing.T) {
+		var outputPath = filepath.Join(t.TempDir(), "output")
+		var want = "existing=value\nversion=1.3.0\nprevious-version=1.2.0\nlevel=minor\ntag=v1.3.0\n"
+
+		assert.NoError(t, os.WriteFile(outputPath, []byte("existing=value\n"), 0o644))
+
+		var platform = NewGitHubActionsPlatform(outputPath, "")
+		var err = platform.WriteOutputs(Outputs{Version: "1.3.0", PreviousVersion: "1.2.0", Level: "minor", Tag: "v1.3.0"})
+
+		assert.NoError(t, err)
+
+		var got, _ = os.ReadFile(outputPath)
+		assert.Equal(t, want, string(got), `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("SkipEmptyOutputs", func(t *testing.T) {
+		var outputPath = filepath.Join(t.TempDir(), "output")
+		var want = "version=1.2.0\ntag=v1.2.0\n"
+
+		var platform = NewGitHubActionsPlatform(outputPath, "")
+		var err = platform.WriteOutputs(Outputs{Version: "1.2.0", Tag: "v1.2.0"})
+
+		assert.NoError(t, err)
+
+		var got, _ = os.ReadFile(outputPath)
+		assert.Equal(t, want, string(got), `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("AppendStepSummary", func(t *testing.T) {
+		var summaryPath = filepath.Join(t.TempDir(), "summary")
+
+		var platform = NewGitHubActionsPlatform("", summaryPath)
+		var err = platform.WriteOutputs(Outputs{Version: "1.3.0", PreviousVersion: "1.2.0", Level: "minor", Tag: "v1.3.0"})
+
+		assert.NoError(t, err)
+
+		var got, _ = os.ReadFile(summaryPath)
+		assert.Contains(t, string(got), "| 1.2.0 | minor | v1.3.0 |")
+	})
+
+	t.Run("ReturnErrorIfFileCannotBeWritten", func(t *testing.T) {
+		var platform = NewGitHubActionsPlatform(t.TempDir(), "")
+		var err = platform.WriteOutputs(Outputs{Version: "1.3.0"})
+
+		assert.Error(t, err)
+	})
+}
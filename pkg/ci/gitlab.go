@@ -0,0 +1,52 @@
+package ci
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// GitLabCIPlatform a Platform which writes a dotenv report, which GitLab passes to later jobs as variables.
+type GitLabCIPlatform struct {
+	DotenvPath string
+}
+
+// NewGitLabCIPlatform creates a new GitLabCIPlatform.
+// Nothing is written to an empty path.
+// Returns the new GitLabCIPlatform.
+func NewGitLabCIPlatform(dotenvPath string) GitLabCIPlatform {
+	return GitLabCIPlatform{DotenvPath: dotenvPath}
+}
+
+// WriteOutputs writes the outputs as SBOT_VERSION, SBOT_PREVIOUS_VERSION, SBOT_LEVEL and SBOT_TAG to the dotenv report,
+// replacing the outputs of an earlier command in the same job.
+// Returns an error if the dotenv report could not be written.
+func (platform GitLabCIPlatform) WriteOutputs(outputs Outputs) (err error) {
+	if platform.DotenvPath == "" {
+		return err
+	}
+
+	var lines strings.Builder
+
+	for _, output := range [][2]string{
+		{"SBOT_VERSION", outputs.Version},
+		{"SBOT_PREVIOUS_VERSION", outputs.PreviousVersion},
+		{"SBOT_LEVEL", outputs.Level},
+		{"SBOT_TAG", outputs.Tag},
+	} {
+		if output[1] != "" {
+			fmt.Fprintf(&lines, "%s=%s\n", output[0], output[1])
+		}
+	}
+
+	if err = os.WriteFile(platform.DotenvPath, []byte(lines.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write '%s': %w", platform.DotenvPath, err)
+	}
+
+	return err
+}
+
+// String returns a string representation of an instance.
+func (platform GitLabCIPlatform) String() string {
+	return GitLabCI
+}
@@ -0,0 +1,31 @@
+package ci
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitLabCIPlatform_WriteOutputs(t *testing.T) {
+	t.Run("ReplaceDotenvReport", func(t *testing.T) {
+		var dotenvPath = filepath.Join(t.TempDir(), "sbot.env")
+		var want = "SBOT_VERSION=1.3.0\nSBOT_PREVIOUS_VERSION=1.2.0\nSBOT_LEVEL=minor\nSBOT_TAG=v1.3.0\n"
+
+		assert.NoError(t, os.WriteFile(dotenvPath, []byte("SBOT_VERSION=1.2.0\n"), 0o644))
+
+		var platform = NewGitLabCIPlatform(dotenvPath)
+		var err = platform.WriteOutputs(Outputs{Version: "1.3.0", PreviousVersion: "1.2.0", Level: "minor", Tag: "v1.3.0"})
+
+		assert.NoError(t, err)
+
+		var got, _ = os.ReadFile(dotenvPath)
+		assert.Equal(t, want, string(got), `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("WriteNothingWithoutPath", func(t *testing.T) {
+		var platform = NewGitLabCIPlatform("")
+		assert.NoError(t, platform.WriteOutputs(Outputs{Version: "1.3.0"}))
+	})
+}
@@ -0,0 +1,11 @@
+package cli
+
+const (
+	// NoConfigValidationAnnotation a command annotation which indicates the config file should not be validated before
+	// the command runs, e.g. because the command validates it itself.
+	NoConfigValidationAnnotation = "sbot/no-config-validation"
+
+	// OfflineAnnotation a command annotation which indicates the command works without a git repository,
+	// e.g. to skip configuring git before it runs.
+	OfflineAnnotation = "sbot/offline"
+)
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"os"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/restechnica/semverbot/pkg/ext/viperx"
 	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
 )
 
 func init() {
@@ -30,17 +32,33 @@ func NewRootCommand() *cobra.Command {
 	}
 
 	command.PersistentFlags().StringVarP(&cli.ConfigFlag, "config", "c", cli.DefaultConfigFilePath, "configures which config file to use")
+	command.PersistentFlags().StringVarP(&cli.RepoFlag, "repo", "C", "", "run as if sbot was started in this path instead of the current working directory")
+
+	command.PersistentFlags().BoolVar(&cli.DryRunFlag, "dry-run", false, "log git operations which modify anything instead of performing them")
+	command.PersistentFlags().StringVarP(&cli.OutputFlag, "output", "o", cli.TextFormat, "output format, either text, json, yaml or env")
+	command.PersistentFlags().DurationVar(&cli.TimeoutFlag, "timeout", 0, "stop the command and any running git command after this duration, e.g. 30s or 5m")
+
+	cli.AddConfigFlags(command.PersistentFlags())
 
 	command.PersistentFlags().BoolVarP(&cli.VerboseFlag, "verbose", "v", false, "increase log level verbosity to Info")
 	command.PersistentFlags().BoolVarP(&cli.DebugFlag, "debug", "d", false, "increase log level verbosity to Debug")
 
 	command.AddCommand(v1.NewV1Command())
+	command.AddCommand(v1.NewBumpCommand())
+	command.AddCommand(v1.NewCheckCommand())
+	command.AddCommand(v1.NewCompareCommand())
+	command.AddCommand(v1.NewConfigCommand())
+	command.AddCommand(v1.NewDeleteCommand())
+	command.AddCommand(v1.NewExplainCommand())
 	command.AddCommand(v1.NewGetCommand())
 	command.AddCommand(v1.NewInitCommand())
+	command.AddCommand(v1.NewListCommand())
 	command.AddCommand(v1.NewPredictCommand())
 	command.AddCommand(v1.NewPushCommand())
 	command.AddCommand(v1.NewReleaseCommand())
+	command.AddCommand(v1.NewRetractCommand())
 	command.AddCommand(v1.NewUpdateCommand())
+	command.AddCommand(v1.NewVerifyCommand())
 	command.AddCommand(v1.NewVersionCommand())
 
 	return command
@@ -56,6 +74,12 @@ func RootCommandPersistentPreRunE(cmd *cobra.Command, args []string) (err error)
 
 	log.Debug().Str("command", "root").Msg("starting pre-run...")
 
+	if err = cli.ValidateOutputFormat(cli.OutputFlag); err != nil {
+		return err
+	}
+
+	SetTimeout(cmd)
+
 	log.Debug().Msg("loading default config values...")
 
 	LoadEnvironmentVariablesConfig()
@@ -66,14 +90,23 @@ func RootCommandPersistentPreRunE(cmd *cobra.Command, args []string) (err error)
 		return err
 	}
 
+	if cmd.Annotations[cli.NoConfigValidationAnnotation] == "" {
+		if err = ValidateConfigFile(); err != nil {
+			return err
+		}
+	}
+
 	if err = LoadFlagsIntoConfig(cmd); err != nil {
 		return err
 	}
 
-	log.Debug().Msg("configuring git...")
+	// commands which work without a git repository do not need git to be configured
+	if cmd.Annotations[cli.OfflineAnnotation] == "" {
+		log.Debug().Msg("configuring git...")
 
-	if err = SetGitConfigIfConfigured(); err != nil {
-		return err
+		if err = SetGitConfigIfConfigured(cmd.Context()); err != nil {
+			return err
+		}
 	}
 
 	// silence errors which at this point are unrelated to CLI (cobra/viper) errors
@@ -82,6 +115,19 @@ func RootCommandPersistentPreRunE(cmd *cobra.Command, args []string) (err error)
 	return err
 }
 
+// SetTimeout sets a timeout on the context of a command if the timeout flag was used.
+// The context is cancelled when the command finishes.
+func SetTimeout(cmd *cobra.Command) {
+	if cli.TimeoutFlag <= 0 {
+		return
+	}
+
+	var ctx, cancel = context.WithTimeout(cmd.Context(), cli.TimeoutFlag)
+
+	cobra.OnFinalize(cancel)
+	cmd.SetContext(ctx)
+}
+
 func ConfigureLogging() {
 	SetLogLevel()
 }
@@ -89,6 +135,11 @@ func ConfigureLogging() {
 func SetLogLevel() {
 	zerolog.SetGlobalLevel(zerolog.ErrorLevel)
 
+	// show the skipped git operations of a dry run
+	if cli.DryRunFlag {
+		zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	}
+
 	if cli.VerboseFlag {
 		zerolog.SetGlobalLevel(zerolog.InfoLevel)
 	}
@@ -98,23 +149,27 @@ func SetLogLevel() {
 	}
 }
 
-// LoadEnvironmentVariablesConfig
+// LoadEnvironmentVariablesConfig enables overriding config properties with SBOT_ prefixed environment variables.
 func LoadEnvironmentVariablesConfig() {
-	viper.SetEnvPrefix("SBOT")
-	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.SetEnvPrefix(cli.EnvPrefix)
+	viper.SetEnvKeyReplacer(cli.EnvKeyReplacer)
+	viper.AutomaticEnv()
 }
 
 // LoadConfigFile loads the SemverBot configuration file.
 // If the config flag was used, it will try to load only that path.
 // If the config flag was not used, multiple default config file paths will be tried.
+// Relative paths are resolved against the repo flag path if it was used.
 // Returns no error if config files are not found, returns an error if it fails otherwise.
 func LoadConfigFile(cmd *cobra.Command) (err error) {
 	configFlag := cmd.Flag("config")
 
 	if configFlag.Changed {
-		if err = viperx.LoadConfig(cli.ConfigFlag); err != nil {
+		var path = cli.ResolveRepoPath(cli.ConfigFlag)
+
+		if err = viperx.LoadConfig(path); err != nil {
 			if errors.As(err, &viper.ConfigFileNotFoundError{}) {
-				log.Warn().Msgf("config file %s not found", cli.ConfigFlag)
+				log.Warn().Msgf("config file %s not found", path)
 				return nil
 			}
 		}
@@ -125,6 +180,8 @@ func LoadConfigFile(cmd *cobra.Command) (err error) {
 	paths := append([]string{cli.DefaultConfigFilePath}, cli.DefaultAdditionalConfigFilePaths...)
 
 	for _, path := range paths {
+		path = cli.ResolveRepoPath(path)
+
 		if err = viperx.LoadConfig(path); err == nil {
 			return err
 		}
@@ -140,34 +197,69 @@ func LoadConfigFile(cmd *cobra.Command) (err error) {
 	return err
 }
 
+// ValidateConfigFile validates the loaded config file and the config files it extends, if any, see cli.ValidateConfig.
+// Returns an error listing each invalid config property, or an error if a config file could not be read.
+func ValidateConfigFile() (err error) {
+	var paths = viperx.ConfigFiles()
+
+	log.Debug().Strs("paths", paths).Msg("validating config files...")
+
+	var errs []error
+
+	if errs, err = cli.ValidateConfigFiles(paths); err != nil {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
+
 // LoadDefaultConfigValues loads the default SemverBot config.
 func LoadDefaultConfigValues() {
+	viper.SetDefault(cli.CIGitHubStepSummaryConfigKey, false)
+	viper.SetDefault(cli.CIGitLabDotenvConfigKey, cli.DefaultCIGitLabDotenv)
+	viper.SetDefault(cli.CIOutputsConfigKey, false)
+	viper.SetDefault(cli.ConstraintsConfigKey, []versions.Constraint{})
+	viper.SetDefault(cli.GitBackendConfigKey, cli.DefaultGitBackend)
+	viper.SetDefault(cli.GitPushRemotesConfigKey, []string{})
+	viper.SetDefault(cli.GitPushRetriesConfigKey, cli.DefaultGitPushRetries)
+	viper.SetDefault(cli.GitRemoteConfigKey, cli.DefaultGitRemote)
+	viper.SetDefault(cli.GitTagsMergedConfigKey, false)
 	viper.SetDefault(cli.GitTagsPrefixConfigKey, cli.DefaultGitTagsPrefix)
+	viper.SetDefault(cli.GitTagsProjectConfigKey, "")
+	viper.SetDefault(cli.GitTagsSignConfigKey, false)
+	viper.SetDefault(cli.GitTagsSigningFormatConfigKey, "")
+	viper.SetDefault(cli.GitTagsSigningKeyConfigKey, "")
 	viper.SetDefault(cli.GitTagsSuffixConfigKey, cli.DefaultGitTagsSuffix)
+	viper.SetDefault(cli.GitTagsTemplateConfigKey, cli.DefaultGitTagsTemplate)
 	viper.SetDefault(cli.ModeConfigKey, cli.DefaultMode)
 	viper.SetDefault(cli.ModesGitBranchDelimitersConfigKey, cli.DefaultGitBranchDelimiters)
 	viper.SetDefault(cli.ModesGitCommitDelimitersConfigKey, cli.DefaultGitCommitDelimiters)
 	viper.SetDefault(cli.SemverMapConfigKey, semver.Map{})
 }
 
-// LoadFlagsIntoConfig loads root command flags.
+// LoadFlagsIntoConfig binds the config flags, which override environment variables and the config file when used.
 // Returns an error if it fails.
 func LoadFlagsIntoConfig(cmd *cobra.Command) (err error) {
-	//err = viper.BindPFlag("git.tags.prefix", cmd.Flags().Lookup("prefix"))
-	return err
+	return cli.BindConfigFlags(cmd.Flags())
 }
 
 // SetGitConfigIfConfigured Sets the git config only when the SemverBot config exists and the git config does not exist.
+// The git config is not written if the dry run flag is used.
 // Returns an error if it fails.
-func SetGitConfigIfConfigured() (err error) {
-	var gitAPI = git.NewCLI()
+func SetGitConfigIfConfigured(ctx context.Context) (err error) {
+	var gitAPI = git.NewAPI(viper.GetString(cli.GitBackendConfigKey), cli.RepoFlag)
+
+	if cli.DryRunFlag {
+		gitAPI = git.NewDryRun(gitAPI)
+	}
+
 	var value string
 
 	if viper.IsSet(cli.GitConfigEmailConfigKey) {
 		var email = viper.GetString(cli.GitConfigEmailConfigKey)
 		value = email
 
-		if value, err = gitAPI.SetConfigIfNotSet("user.email", email); err != nil {
+		if value, err = gitAPI.SetConfigIfNotSet(ctx, "user.email", email); err != nil {
 			return err
 		}
 	}
@@ -178,7 +270,7 @@ func SetGitConfigIfConfigured() (err error) {
 		var name = viper.GetString(cli.GitConfigNameConfigKey)
 		value = name
 
-		if value, err = gitAPI.SetConfigIfNotSet("user.name", name); err != nil {
+		if value, err = gitAPI.SetConfigIfNotSet(ctx, "user.name", name); err != nil {
 			return err
 		}
 	}
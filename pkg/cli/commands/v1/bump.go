@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+	"github.com/restechnica/semverbot/pkg/modes"
+)
+
+// NewBumpCommand creates a new bump command.
+// Returns the new spf13/cobra command.
+func NewBumpCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:         "bump <version>",
+		Short:       "increment a version without a git repository",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{cli.OfflineAnnotation: "true"},
+		RunE:        BumpCommandRunE,
+	}
+
+	command.Flags().StringVarP(&cli.LevelFlag, "level", "l", modes.Patch, "semver level to increment, either patch, minor or major")
+	command.Flags().StringVar(&cli.PrereleaseFlag, "prerelease", "", "prerelease to append to the incremented version, e.g. rc.1")
+
+	return command
+}
+
+// BumpCommandRunE runs the command.
+// Returns an error if the command fails.
+func BumpCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.bump").Msg("starting run...")
+
+	var options = &core.BumpVersionOptions{
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		Level:           cli.LevelFlag,
+		Prerelease:      cli.PrereleaseFlag,
+	}
+
+	log.Debug().
+		Str("version", args[0]).
+		Str("level", options.Level).
+		Str("prerelease", options.Prerelease).
+		Msg("options")
+
+	var result core.VersionResult
+
+	if result, err = core.BumpVersion(cmd.Context(), args[0], options); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, result.Version)
+}
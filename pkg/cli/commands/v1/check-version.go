@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// NewCheckVersionCommand creates a new check version command.
+// Returns the new spf13/cobra command.
+func NewCheckVersionCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:  "version [version]",
+		Args: cobra.MaximumNArgs(1),
+		RunE: CheckVersionCommandRunE,
+	}
+
+	command.Flags().StringVar(&cli.ConstraintFlag, "constraint", "", `range the version should satisfy, e.g. "^1.4" or ">=1.2 <2"`)
+	command.Flags().BoolVar(&cli.PredictFlag, "predict", false, "check the predicted version instead of the current version")
+	command.Flags().StringVar(&cli.BranchFlag, "branch", "", "branch to match constraints against, defaults to the branch of the ref or of the ci pipeline")
+	command.Flags().StringVar(&cli.GitRefFlag, "ref", cli.DefaultGitRef, "git ref to predict the version for, e.g. a commit hash, branch or tag")
+
+	_ = command.MarkFlagRequired("constraint")
+
+	return command
+}
+
+// CheckVersionCommandRunE runs the command.
+// The given version is checked, otherwise the predicted version if the predict flag is used or else the current version.
+// Returns an error if the command fails or if the version does not satisfy the range.
+func CheckVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.check-version").Msg("starting run...")
+
+	var version string
+
+	switch {
+	case len(args) == 1:
+		version = args[0]
+	case cli.PredictFlag:
+		var options *core.PredictVersionOptions
+
+		if options, err = NewPredictVersionOptions(); err != nil {
+			return err
+		}
+
+		var result core.VersionResult
+
+		if result, err = core.PredictVersion(cmd.Context(), options); err != nil {
+			return cli.NewCommandError(err)
+		}
+
+		version = result.Version
+	default:
+		var options = &core.GetVersionOptions{
+			GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+			GitTagsMerged:   viper.GetBool(cli.GitTagsMergedConfigKey),
+			GitTagPrefix:    viper.GetString(cli.GitTagsPrefixConfigKey),
+			GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+			GitTagSuffix:    viper.GetString(cli.GitTagsSuffixConfigKey),
+			GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+			DefaultVersion:  cli.DefaultVersion,
+			RepoPath:        cli.RepoFlag,
+		}
+
+		var result core.VersionResult
+
+		if result, err = core.GetVersion(cmd.Context(), options); err != nil {
+			return cli.NewCommandError(err)
+		}
+
+		version = result.Version
+	}
+
+	log.Debug().
+		Str("version", version).
+		Str("constraint", cli.ConstraintFlag).
+		Bool("predict", cli.PredictFlag).
+		Msg("options")
+
+	if err = core.CheckVersion(version, cli.ConstraintFlag); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	log.Info().Msgf("version %s satisfies the range '%s'", version, cli.ConstraintFlag)
+
+	return err
+}
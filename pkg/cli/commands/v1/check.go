@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCheckCommand creates a new check command.
+// Returns the new spf13/cobra command.
+func NewCheckCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "check",
+	}
+
+	command.AddCommand(NewCheckVersionCommand())
+
+	return command
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// WriteCIOutputs writes a version result as outputs of the CI platform sbot runs on, if any.
+// Returns an error if the outputs could not be written.
+func WriteCIOutputs(result core.VersionResult) error {
+	var options = &core.WriteCIOutputsOptions{
+		GitHubStepSummary: viper.GetBool(cli.CIGitHubStepSummaryConfigKey),
+		DryRun:            cli.DryRunFlag,
+		GitLabDotenv:      cli.ResolveRepoPath(viper.GetString(cli.CIGitLabDotenvConfigKey)),
+		Outputs:           viper.GetBool(cli.CIOutputsConfigKey),
+	}
+
+	return core.WriteCIOutputs(result, options)
+}
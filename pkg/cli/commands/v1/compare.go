@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// NewCompareCommand creates a new compare command.
+// Returns the new spf13/cobra command.
+func NewCompareCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:         "compare <a> <b>",
+		Short:       "compare two versions without a git repository, prints -1, 0 or 1",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{cli.OfflineAnnotation: "true"},
+		RunE:        CompareCommandRunE,
+	}
+
+	return command
+}
+
+// CompareCommandRunE runs the command.
+// Prints -1 if the first version precedes the second, 0 if they are equal or 1 if the first version follows the second.
+// Returns an error if the command fails.
+func CompareCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.compare").Msg("starting run...")
+
+	var options = &core.CompareVersionsOptions{
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+	}
+
+	log.Debug().Str("a", args[0]).Str("b", args[1]).Msg("options")
+
+	var result core.ComparisonResult
+
+	if result, err = core.CompareVersions(args[0], args[1], options); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, strconv.Itoa(result.Comparison))
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+)
+
+// NewConfigShowCommand creates a new config show command.
+// Returns the new spf13/cobra command.
+func NewConfigShowCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:         "show",
+		Short:       "show the effective config and where each value came from",
+		Annotations: map[string]string{cli.NoConfigValidationAnnotation: "true", cli.OfflineAnnotation: "true"},
+		RunE:        ConfigShowCommandRunE,
+	}
+
+	return command
+}
+
+// ConfigShowCommandRunE runs the command.
+// Returns an error if the command fails.
+func ConfigShowCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.config-show").Msg("starting run...")
+
+	var values = cli.GetConfigValues(cmd.Flags())
+	var lines = make([]string, len(values))
+
+	for i, value := range values {
+		lines[i] = value.String()
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, values, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/ext/viperx"
+)
+
+// NewConfigValidateCommand creates a new config validate command.
+// Returns the new spf13/cobra command.
+func NewConfigValidateCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:         "validate",
+		Short:       "validate the config file",
+		Annotations: map[string]string{cli.NoConfigValidationAnnotation: "true", cli.OfflineAnnotation: "true"},
+		RunE:        ConfigValidateCommandRunE,
+	}
+
+	return command
+}
+
+// ConfigValidateCommandRunE runs the command.
+// Returns an error listing each invalid config property if the config file is invalid or if the command fails.
+func ConfigValidateCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.config-validate").Msg("starting run...")
+
+	var paths = viperx.ConfigFiles()
+
+	if len(paths) == 0 {
+		return cli.NewCommandError(errors.New("no config file found"))
+	}
+
+	var errs []error
+
+	if errs, err = cli.ValidateConfigFiles(paths); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	if len(errs) > 0 {
+		return cli.NewCommandError(errors.Join(errs...))
+	}
+
+	var validations []cli.ConfigValidation
+	var lines []string
+
+	for _, path := range paths {
+		validations = append(validations, cli.ConfigValidation{File: path, Valid: true})
+		lines = append(lines, fmt.Sprintf("%s is valid", path))
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, validations, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewConfigCommand creates a new config command.
+// Returns the new spf13/cobra command.
+func NewConfigCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "config",
+	}
+
+	command.AddCommand(NewConfigShowCommand())
+	command.AddCommand(NewConfigValidateCommand())
+
+	return command
+}
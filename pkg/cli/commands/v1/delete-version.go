@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// NewDeleteVersionCommand creates a new delete version command.
+// Returns the new spf13/cobra command.
+func NewDeleteVersionCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:  "version <version>",
+		Args: cobra.ExactArgs(1),
+		RunE: DeleteVersionCommandRunE,
+	}
+
+	command.Flags().BoolVar(&cli.PushFlag, "push", false, "delete the version on the git push remotes as well")
+
+	return command
+}
+
+// DeleteVersionCommandRunE runs the command.
+// Returns an error if the command fails.
+func DeleteVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.delete-version").Msg("starting run...")
+
+	var options = &core.DeleteVersionOptions{
+		DryRun:          cli.DryRunFlag,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitPushRemotes:  cli.GetStringSlice(cli.GitPushRemotesConfigKey),
+		GitRemote:       viper.GetString(cli.GitRemoteConfigKey),
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		Push:            cli.PushFlag,
+		RepoPath:        cli.RepoFlag,
+	}
+
+	log.Debug().
+		Str("version", args[0]).
+		Bool("push", options.Push).
+		Strs("remotes", options.GitPushRemotes).
+		Str("prefix", options.GitTagsPrefix).
+		Str("suffix", options.GitTagsSuffix).
+		Str("template", options.GitTagsTemplate).
+		Msg("options")
+
+	if err = core.DeleteVersion(cmd.Context(), args[0], options); err != nil {
+		err = cli.NewCommandError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCommand creates a new delete command.
+// Returns the new spf13/cobra command.
+func NewDeleteCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "delete",
+	}
+
+	command.AddCommand(NewDeleteVersionCommand())
+
+	return command
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+// NewExplainCommand creates a new explain command.
+// Returns the new spf13/cobra command.
+func NewExplainCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "explain",
+		Short: "explain why a version is predicted",
+		RunE:  ExplainCommandRunE,
+	}
+
+	command.Flags().StringVar(&cli.BranchFlag, "branch", "", "branch to match constraints against, defaults to the branch of the ref or of the ci pipeline")
+	command.Flags().StringVar(&cli.GitRefFlag, "ref", cli.DefaultGitRef, "git ref to explain the prediction for, e.g. a commit hash, branch or tag")
+
+	return command
+}
+
+// ExplainCommandRunE runs the command.
+// The explanation is printed even if the prediction failed, to show why it failed.
+// Returns an error if the command fails.
+func ExplainCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.explain").Msg("starting run...")
+
+	var options *core.PredictVersionOptions
+
+	if options, err = NewPredictVersionOptions(); err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("mode", options.Mode).
+		Str("ref", options.Ref).
+		Bool("merged", options.GitTagsMerged).
+		Msg("options")
+
+	var explanation, explainErr = core.ExplainVersion(cmd.Context(), options)
+	var text strings.Builder
+
+	WriteVersionExplanation(&text, explanation)
+
+	if err = cli.WriteOutput(os.Stdout, cli.OutputFlag, explanation, strings.TrimSuffix(text.String(), "\n")); err != nil {
+		return err
+	}
+
+	if explainErr != nil {
+		err = cli.NewCommandError(explainErr)
+	}
+
+	return err
+}
+
+// WriteVersionExplanation writes a human-readable version explanation.
+func WriteVersionExplanation(writer io.Writer, explanation core.VersionExplanation) {
+	if explanation.Tag == "" {
+		fmt.Fprintf(writer, "current version: %s (default, no tag found)\n", explanation.Version)
+	} else if explanation.Retracted {
+		fmt.Fprintf(writer, "latest version: %s (tag %s, retracted)\n", explanation.Version, explanation.Tag)
+	} else {
+		fmt.Fprintf(writer, "current version: %s (tag %s)\n", explanation.Version, explanation.Tag)
+	}
+
+	fmt.Fprintf(writer, "mode: %s\n", explanation.Mode.Mode)
+
+	if len(explanation.Mode.Tried) == 0 {
+		writeModeExplanation(writer, explanation.Mode, "  ")
+	}
+
+	for i, tried := range explanation.Mode.Tried {
+		fmt.Fprintf(writer, "  %d. %s\n", i+1, tried.Mode)
+		writeModeExplanation(writer, tried, "     ")
+	}
+
+	if explanation.Prediction == "" {
+		return
+	}
+
+	if explanation.Clamped {
+		fmt.Fprintf(writer, "clamped: from %s by a version constraint\n", explanation.Mode.Version)
+	}
+
+	if len(explanation.Skipped) > 0 {
+		fmt.Fprintf(writer, "skipped: %s (already released on a ref which is not merged)\n", strings.Join(explanation.Skipped, ", "))
+	}
+
+	fmt.Fprintf(writer, "prediction: %s\n", explanation.Prediction)
+}
+
+// writeModeExplanation writes a human-readable mode explanation, each line prefixed by an indent.
+func writeModeExplanation(writer io.Writer, explanation modes.Explanation, indent string) {
+	if explanation.Fallback {
+		fmt.Fprintf(writer, "%sused as fallback because no other mode succeeded\n", indent)
+	}
+
+	if explanation.Source != "" {
+		fmt.Fprintf(writer, "%s%s: %q\n", indent, explanation.Source, strings.TrimSpace(explanation.Input))
+	}
+
+	if explanation.Delimiters != "" {
+		fmt.Fprintf(writer, "%sdelimiters: %q\n", indent, explanation.Delimiters)
+	}
+
+	if len(explanation.Tokens) > 0 {
+		var tokens = make([]string, len(explanation.Tokens))
+
+		for i, token := range explanation.Tokens {
+			tokens[i] = fmt.Sprintf("%q", token)
+		}
+
+		fmt.Fprintf(writer, "%stokens: %s\n", indent, strings.Join(tokens, ", "))
+	}
+
+	if explanation.Source != "" && explanation.Input != "" {
+		if len(explanation.Matches) == 0 {
+			fmt.Fprintf(writer, "%smatches: none, the semver map contains %s\n", indent, formatSemverMap(explanation.SemverMap))
+		}
+
+		for _, match := range explanation.Matches {
+			fmt.Fprintf(writer, "%smatches: %q is %s\n", indent, match.Token, match.Level)
+		}
+	}
+
+	if explanation.Err != nil {
+		fmt.Fprintf(writer, "%sfailed: %s\n", indent, explanation.Err)
+		return
+	}
+
+	fmt.Fprintf(writer, "%sresult: %s increment to %s\n", indent, explanation.Detected, explanation.Version)
+}
+
+// formatSemverMap formats the levels and values of a semver map in a stable order.
+// Returns the formatted semver map, e.g. minor=["feature"], patch=["fix" "bug"].
+func formatSemverMap(semverMap semver.Map) string {
+	if len(semverMap) == 0 {
+		return "no values"
+	}
+
+	var levels = make([]string, 0, len(semverMap))
+
+	for level := range semverMap {
+		levels = append(levels, level)
+	}
+
+	sort.Strings(levels)
+
+	for i, level := range levels {
+		levels[i] = fmt.Sprintf("%s=%q", level, semverMap[level])
+	}
+
+	return strings.Join(levels, ", ")
+}
@@ -1,7 +1,7 @@
 package v1
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
@@ -15,25 +15,61 @@ import (
 // Returns the new spf13/cobra command.
 func NewGetVersionCommand() *cobra.Command {
 	var command = &cobra.Command{
-		Use: "version",
-		Run: GetVersionCommandRun,
+		Use:     "version",
+		PreRunE: GetVersionCommandPreRunE,
+		RunE:    GetVersionCommandRunE,
 	}
 
+	command.Flags().StringVar(&cli.OutputTemplateFlag, "template", "", "template to print the version with, e.g. {{.Major}}.{{.Minor}}")
+
 	return command
 }
 
-// GetVersionCommandRun runs the command.
-func GetVersionCommandRun(cmd *cobra.Command, args []string) {
+// GetVersionCommandPreRunE runs before the command runs.
+// Returns an error if the template flag is used with an output other than text.
+func GetVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
+	return cli.ValidateOutputTemplate(cli.OutputFlag, cli.OutputTemplateFlag)
+}
+
+// GetVersionCommandRunE runs the command.
+// Returns an error if the command fails.
+func GetVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.get-version").Msg("starting run...")
 
 	var options = &core.GetVersionOptions{
-		GitTagPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
-		GitTagSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
-		DefaultVersion: cli.DefaultVersion,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitTagsMerged:   viper.GetBool(cli.GitTagsMergedConfigKey),
+		GitTagPrefix:    viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagSuffix:    viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		DefaultVersion:  cli.DefaultVersion,
+		RepoPath:        cli.RepoFlag,
 	}
 
 	log.Debug().Str("default", options.DefaultVersion).Msg("options")
 
-	var version = core.GetVersion(options)
-	fmt.Println(version)
+	var result core.VersionResult
+
+	if result, err = core.GetVersion(cmd.Context(), options); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	if err = WriteCIOutputs(result); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	var formatOptions = &core.FormatVersionOptions{
+		GitTagsPrefix: options.GitTagPrefix,
+		GitTagsSuffix: options.GitTagSuffix,
+		Template:      cli.OutputTemplateFlag,
+	}
+
+	var text string
+
+	if text, err = core.FormatVersion(result, formatOptions); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, text)
 }
@@ -2,9 +2,11 @@ package v1
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/restechnica/semverbot/pkg/cli"
 	"github.com/restechnica/semverbot/pkg/core"
@@ -16,25 +18,39 @@ func NewInitCommand() *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "init",
 		RunE:  InitCommandRunE,
-		Short: fmt.Sprintf(`Creates a default "%s" config`, cli.DefaultConfigFilePath),
+		Short: fmt.Sprintf(`Creates a "%s" config tailored to the repository`, cli.DefaultConfigFilePath),
+		// the config is about to be replaced, so an invalid config should not stop it
+		Annotations: map[string]string{cli.NoConfigValidationAnnotation: "true"},
 	}
 
+	command.Flags().BoolVarP(&cli.NonInteractiveFlag, "yes", "y", false, "use the inferred and default answers without prompting, overwriting an existing config")
+	command.Flags().BoolVar(&cli.NonInteractiveFlag, "non-interactive", false, "same as --yes")
+
 	return command
 }
 
 // InitCommandRunE runs the init command.
+// It only prompts if stdin is a terminal, so it works in pipelines without the yes flag as well.
+// An existing config is only overwritten without a terminal if the yes flag is used.
 // Returns an error if the command failed.
 func InitCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.init").Msg("starting run...")
 
 	var options = &core.InitOptions{
-		Config:         cli.GetDefaultConfig(),
-		ConfigFilePath: cli.ConfigFlag,
+		Config:         cli.GetDefaultInitConfig(),
+		ConfigFilePath: cli.ResolveRepoPath(cli.ConfigFlag),
+		Interactive:    !cli.NonInteractiveFlag && term.IsTerminal(int(os.Stdin.Fd())),
+		Overwrite:      cli.NonInteractiveFlag,
+		RepoPath:       cli.RepoFlag,
 	}
 
-	log.Debug().Str("config", options.ConfigFilePath).Msg("options")
+	log.Debug().
+		Str("config", options.ConfigFilePath).
+		Bool("interactive", options.Interactive).
+		Bool("overwrite", options.Overwrite).
+		Msg("options")
 
-	if err = core.Init(options); err != nil {
+	if err = core.Init(cmd.Context(), options); err != nil {
 		err = cli.NewCommandError(err)
 	}
 
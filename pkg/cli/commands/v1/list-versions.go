@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+// NewListVersionsCommand creates a new list versions command.
+// Returns the new spf13/cobra command.
+func NewListVersionsCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:     "versions",
+		PreRunE: ListVersionsCommandPreRunE,
+		RunE:    ListVersionsCommandRunE,
+	}
+
+	command.Flags().StringVar(&cli.ConstraintFlag, "constraint", "", `only list versions in this range, e.g. ">=1.2 <2"`)
+	command.Flags().IntVar(&cli.LimitFlag, "limit", 0, "maximum number of versions to list, starting with the latest version")
+	command.Flags().BoolVar(&cli.PrereleasesFlag, "prereleases", false, "list prerelease versions as well")
+
+	return command
+}
+
+// ListVersionsCommandPreRunE runs before the command runs.
+// Returns an error if the limit is invalid.
+func ListVersionsCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
+	if cli.LimitFlag < 0 {
+		return fmt.Errorf("invalid limit %d, expected 0 or more", cli.LimitFlag)
+	}
+
+	return err
+}
+
+// ListVersionsCommandRunE runs the command.
+// Returns an error if the command fails.
+func ListVersionsCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.list-versions").Msg("starting run...")
+
+	var options = &core.ListVersionsOptions{
+		Constraint:      cli.ConstraintFlag,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitTagsMerged:   viper.GetBool(cli.GitTagsMergedConfigKey),
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		Limit:           cli.LimitFlag,
+		Prereleases:     cli.PrereleasesFlag,
+		RepoPath:        cli.RepoFlag,
+	}
+
+	log.Debug().
+		Str("constraint", options.Constraint).
+		Int("limit", options.Limit).
+		Bool("prereleases", options.Prereleases).
+		Bool("merged", options.GitTagsMerged).
+		Str("template", options.GitTagsTemplate).
+		Msg("options")
+
+	var listed []versions.Version
+
+	if listed, err = core.ListVersions(cmd.Context(), options); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	var lines = make([]string, len(listed))
+
+	for i, version := range listed {
+		lines[i] = version.Version
+	}
+
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, listed, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewListCommand creates a new list command.
+// Returns the new spf13/cobra command.
+func NewListCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "list",
+	}
+
+	command.AddCommand(NewListVersionsCommand())
+
+	return command
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+// NewPredictVersionOptions creates the options to predict a version with, from the flags and the configuration.
+// Returns the new options or an error if the constraints could not be read from the configuration.
+func NewPredictVersionOptions() (options *core.PredictVersionOptions, err error) {
+	var constraints []versions.Constraint
+
+	if err = viper.UnmarshalKey(cli.ConstraintsConfigKey, &constraints); err != nil {
+		return nil, err
+	}
+
+	options = &core.PredictVersionOptions{
+		Branch:              cli.BranchFlag,
+		Constraints:         constraints,
+		DefaultVersion:      cli.DefaultVersion,
+		GitBackend:          viper.GetString(cli.GitBackendConfigKey),
+		GitBranchDelimiters: viper.GetString(cli.ModesGitBranchDelimitersConfigKey),
+		GitCommitDelimiters: viper.GetString(cli.ModesGitCommitDelimitersConfigKey),
+		GitTagsMerged:       viper.GetBool(cli.GitTagsMergedConfigKey),
+		GitTagsPrefix:       viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:      viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:       viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate:     viper.GetString(cli.GitTagsTemplateConfigKey),
+		Mode:                viper.GetString(cli.ModeConfigKey),
+		Ref:                 cli.GitRefFlag,
+		RepoPath:            cli.RepoFlag,
+		SemverMap:           cli.GetSemverMap(),
+	}
+
+	return options, nil
+}
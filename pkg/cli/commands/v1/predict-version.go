@@ -1,11 +1,10 @@
 package v1
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
 	"github.com/restechnica/semverbot/pkg/cli"
 	"github.com/restechnica/semverbot/pkg/core"
@@ -20,15 +19,17 @@ func NewPredictVersionCommand() *cobra.Command {
 		RunE:    PredictVersionCommandRunE,
 	}
 
-	command.Flags().StringVarP(&cli.ModeFlag, "mode", "m", "auto", "sbot mode")
+	command.Flags().StringVar(&cli.BranchFlag, "branch", "", "branch to match constraints against, defaults to the branch of the ref or of the ci pipeline")
+	command.Flags().StringVar(&cli.GitRefFlag, "ref", cli.DefaultGitRef, "git ref to predict the version for, e.g. a commit hash, branch or tag")
+	command.Flags().StringVar(&cli.OutputTemplateFlag, "template", "", "template to print the version with, e.g. {{.Major}}.{{.Minor}}")
 
 	return command
 }
 
 // PredictVersionCommandPreRunE runs before the command runs.
-// Returns an error if it fails.
+// Returns an error if it fails or if the template flag is used with an output other than text.
 func PredictVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
-	return viper.BindPFlag(cli.ModeConfigKey, cmd.Flags().Lookup("mode"))
+	return cli.ValidateOutputTemplate(cli.OutputFlag, cli.OutputTemplateFlag)
 }
 
 // PredictVersionCommandRunE runs the command.
@@ -36,28 +37,41 @@ func PredictVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error)
 func PredictVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.predict-version").Msg("starting run...")
 
-	var options = &core.PredictVersionOptions{
-		DefaultVersion:      cli.DefaultVersion,
-		GitBranchDelimiters: viper.GetString(cli.ModesGitBranchDelimitersConfigKey),
-		GitCommitDelimiters: viper.GetString(cli.ModesGitCommitDelimitersConfigKey),
-		GitTagsPrefix:       viper.GetString(cli.GitTagsPrefixConfigKey),
-		GitTagsSuffix:       viper.GetString(cli.GitTagsSuffixConfigKey),
-		Mode:                viper.GetString(cli.ModeConfigKey),
-		SemverMap:           viper.GetStringMapStringSlice(cli.SemverMapConfigKey),
+	var options *core.PredictVersionOptions
+
+	if options, err = NewPredictVersionOptions(); err != nil {
+		return err
 	}
 
 	log.Debug().
 		Str("default", options.DefaultVersion).
 		Str("mode", options.Mode).
+		Str("ref", options.Ref).
+		Bool("merged", options.GitTagsMerged).
+		Int("constraints", len(options.Constraints)).
 		Msg("options")
 
-	var version string
+	var result core.VersionResult
+
+	if result, err = core.PredictVersion(cmd.Context(), options); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	if err = WriteCIOutputs(result); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	var formatOptions = &core.FormatVersionOptions{
+		GitTagsPrefix: options.GitTagsPrefix,
+		GitTagsSuffix: options.GitTagsSuffix,
+		Template:      cli.OutputTemplateFlag,
+	}
+
+	var text string
 
-	if version, err = core.PredictVersion(options); err != nil {
-		err = cli.NewCommandError(err)
-	} else {
-		fmt.Println(version)
+	if text, err = core.FormatVersion(result, formatOptions); err != nil {
+		return cli.NewCommandError(err)
 	}
 
-	return err
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, text)
 }
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"os"
+
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,33 +15,64 @@ import (
 // Returns the new spf13/cobra command.
 func NewPushVersionCommand() *cobra.Command {
 	var command = &cobra.Command{
-		Use:  "version",
-		RunE: PushVersionCommandRunE,
+		Use:     "version",
+		PreRunE: PushVersionCommandPreRunE,
+		RunE:    PushVersionCommandRunE,
 	}
 
+	command.Flags().StringVar(&cli.GitRefFlag, "ref", cli.DefaultGitRef, "git ref the version was released on, e.g. a commit hash, branch or tag")
+	command.Flags().StringSliceVar(&cli.GitPushRemotesFlag, "remote", []string{}, "git remotes to push to")
+
 	return command
 }
 
+// PushVersionCommandPreRunE runs before the command runs.
+// The remote flag overrides the git-push-remotes flag only if it is used.
+// Returns an error if it fails.
+func PushVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
+	if !cmd.Flags().Changed("remote") {
+		return err
+	}
+
+	return viper.BindPFlag(cli.GitPushRemotesConfigKey, cmd.Flags().Lookup("remote"))
+}
+
 // PushVersionCommandRunE runs the command.
 // Returns an error if the command fails.
 func PushVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.push-version").Msg("starting run...")
 
 	var options = &core.PushVersionOptions{
-		DefaultVersion: cli.DefaultVersion,
-		GitTagsPrefix:  viper.GetString(cli.GitTagsPrefixConfigKey),
-		GitTagsSuffix:  viper.GetString(cli.GitTagsSuffixConfigKey),
+		DefaultVersion:  cli.DefaultVersion,
+		DryRun:          cli.DryRunFlag,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitPushRemotes:  cli.GetStringSlice(cli.GitPushRemotesConfigKey),
+		GitRemote:       viper.GetString(cli.GitRemoteConfigKey),
+		GitTagsMerged:   viper.GetBool(cli.GitTagsMergedConfigKey),
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		Ref:             cli.GitRefFlag,
+		RepoPath:        cli.RepoFlag,
 	}
 
 	log.Debug().
 		Str("default", options.DefaultVersion).
+		Strs("remotes", options.GitPushRemotes).
+		Str("ref", options.Ref).
+		Bool("merged", options.GitTagsMerged).
 		Str("prefix", options.GitTagsPrefix).
 		Str("suffix", options.GitTagsSuffix).
+		Str("template", options.GitTagsTemplate).
 		Msg("options")
 
-	if err = core.PushVersion(options); err != nil {
-		err = cli.NewCommandError(err)
+	var result core.VersionResult
+
+	if result, err = core.PushVersion(cmd.Context(), options); err != nil {
+		return cli.NewCommandError(err)
 	}
 
-	return err
+	// nothing is written in the text format, like git push
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, "")
 }
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"os"
+
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -13,47 +15,85 @@ import (
 // Returns the new spf13/cobra command.
 func NewReleaseVersionCommand() *cobra.Command {
 	var command = &cobra.Command{
-		Use:     "version",
-		PreRunE: ReleaseVersionCommandPreRunE,
-		RunE:    ReleaseVersionCommandRunE,
+		Use:  "version",
+		RunE: ReleaseVersionCommandRunE,
 	}
 
-	command.Flags().StringVarP(&cli.ModeFlag, "mode", "m", "", "sbot mode")
+	command.Flags().BoolVar(&cli.PushFlag, "push", false, "push the version after releasing it, retrying if another release pushed the same version")
+	command.Flags().StringVar(&cli.BranchFlag, "branch", "", "branch to match constraints against, defaults to the branch of the ref or of the ci pipeline")
+	command.Flags().StringVar(&cli.GitRefFlag, "ref", cli.DefaultGitRef, "git ref to release the version on, e.g. a commit hash, branch or tag")
 
 	return command
 }
 
-// ReleaseVersionCommandPreRunE runs before the command runs.
-// Returns an error if it fails.
-func ReleaseVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
-	return viper.BindPFlag(cli.ModeConfigKey, cmd.Flags().Lookup("mode"))
-}
-
 // ReleaseVersionCommandRunE runs the command.
 // Returns an error if the command fails.
 func ReleaseVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.release-version").Msg("starting run...")
 
-	var predictOptions = &core.PredictVersionOptions{
-		DefaultVersion:      cli.DefaultVersion,
-		GitBranchDelimiters: viper.GetString(cli.ModesGitBranchDelimitersConfigKey),
-		GitCommitDelimiters: viper.GetString(cli.ModesGitCommitDelimitersConfigKey),
-		GitTagsPrefix:       viper.GetString(cli.GitTagsPrefixConfigKey),
-		GitTagsSuffix:       viper.GetString(cli.GitTagsSuffixConfigKey),
-		Mode:                viper.GetString(cli.ModeConfigKey),
-		SemverMap:           viper.GetStringMapStringSlice(cli.SemverMapConfigKey),
+	var predictOptions *core.PredictVersionOptions
+
+	if predictOptions, err = NewPredictVersionOptions(); err != nil {
+		return err
 	}
 
 	log.Debug().
 		Str("default", predictOptions.DefaultVersion).
 		Str("mode", predictOptions.Mode).
+		Str("ref", predictOptions.Ref).
+		Bool("merged", predictOptions.GitTagsMerged).
+		Int("constraints", len(predictOptions.Constraints)).
 		Str("prefix", predictOptions.GitTagsPrefix).
 		Str("suffix", predictOptions.GitTagsSuffix).
+		Str("template", predictOptions.GitTagsTemplate).
 		Msg("options")
 
-	if err = core.ReleaseVersion(predictOptions); err != nil {
-		err = cli.NewCommandError(err)
+	var releaseOptions = &core.ReleaseVersionOptions{
+		DryRun:               cli.DryRunFlag,
+		GitTagsSign:          viper.GetBool(cli.GitTagsSignConfigKey),
+		GitTagsSigningFormat: viper.GetString(cli.GitTagsSigningFormatConfigKey),
+		GitTagsSigningKey:    viper.GetString(cli.GitTagsSigningKeyConfigKey),
+	}
+
+	log.Debug().
+		Bool("sign", releaseOptions.GitTagsSign).
+		Str("signing-format", releaseOptions.GitTagsSigningFormat).
+		Str("signing-key", releaseOptions.GitTagsSigningKey).
+		Msg("options")
+
+	var result core.VersionResult
+
+	if !cli.PushFlag {
+		if result, err = core.ReleaseVersion(cmd.Context(), predictOptions, releaseOptions); err != nil {
+			return cli.NewCommandError(err)
+		}
+
+		if err = WriteCIOutputs(result); err != nil {
+			return cli.NewCommandError(err)
+		}
+
+		// nothing is written in the text format, like git tag
+		return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, "")
+	}
+
+	var pushOptions = &core.PushVersionOptions{
+		GitPushRemotes: cli.GetStringSlice(cli.GitPushRemotesConfigKey),
+		GitPushRetries: viper.GetInt(cli.GitPushRetriesConfigKey),
+		GitRemote:      viper.GetString(cli.GitRemoteConfigKey),
+	}
+
+	log.Debug().
+		Strs("remotes", pushOptions.GitPushRemotes).
+		Int("retries", pushOptions.GitPushRetries).
+		Msg("options")
+
+	if result, err = core.ReleaseAndPushVersion(cmd.Context(), predictOptions, releaseOptions, pushOptions); err != nil {
+		return cli.NewCommandError(err)
+	}
+
+	if err = WriteCIOutputs(result); err != nil {
+		return cli.NewCommandError(err)
 	}
 
-	return err
+	return cli.WriteOutput(os.Stdout, cli.OutputFlag, result, "")
 }
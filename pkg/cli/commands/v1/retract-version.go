@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// NewRetractVersionCommand creates a new retract version command.
+// Returns the new spf13/cobra command.
+func NewRetractVersionCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:  "version <version>",
+		Args: cobra.ExactArgs(1),
+		RunE: RetractVersionCommandRunE,
+	}
+
+	command.Flags().StringVar(&cli.ReasonFlag, "reason", "", "why the version is retracted")
+	command.Flags().BoolVar(&cli.PushFlag, "push", false, "push the retraction to the git push remotes")
+
+	_ = command.MarkFlagRequired("reason")
+
+	return command
+}
+
+// RetractVersionCommandRunE runs the command.
+// Returns an error if the command fails.
+func RetractVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.retract-version").Msg("starting run...")
+
+	var options = &core.RetractVersionOptions{
+		DryRun:          cli.DryRunFlag,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitPushRemotes:  cli.GetStringSlice(cli.GitPushRemotesConfigKey),
+		GitRemote:       viper.GetString(cli.GitRemoteConfigKey),
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		Push:            cli.PushFlag,
+		RepoPath:        cli.RepoFlag,
+	}
+
+	log.Debug().
+		Str("version", args[0]).
+		Str("reason", cli.ReasonFlag).
+		Bool("push", options.Push).
+		Strs("remotes", options.GitPushRemotes).
+		Str("prefix", options.GitTagsPrefix).
+		Str("suffix", options.GitTagsSuffix).
+		Str("template", options.GitTagsTemplate).
+		Msg("options")
+
+	if err = core.RetractVersion(cmd.Context(), args[0], cli.ReasonFlag, options); err != nil {
+		err = cli.NewCommandError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewRetractCommand creates a new retract command.
+// Returns the new spf13/cobra command.
+func NewRetractCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "retract",
+	}
+
+	command.AddCommand(NewRetractVersionCommand())
+
+	return command
+}
@@ -12,12 +12,21 @@ func NewV1Command() *cobra.Command {
 		Short: "v1 sbot API",
 	}
 
+	command.AddCommand(NewBumpCommand())
+	command.AddCommand(NewCheckCommand())
+	command.AddCommand(NewCompareCommand())
+	command.AddCommand(NewConfigCommand())
+	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewExplainCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewInitCommand())
+	command.AddCommand(NewListCommand())
 	command.AddCommand(NewPredictCommand())
 	command.AddCommand(NewPushCommand())
 	command.AddCommand(NewReleaseCommand())
+	command.AddCommand(NewRetractCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewVerifyCommand())
 	command.AddCommand(NewVersionCommand())
 
 	return command
@@ -13,24 +13,42 @@ import (
 // Returns the new spf13/cobra command.
 func NewUpdateVersionCommand() *cobra.Command {
 	var command = &cobra.Command{
-		Use:  "version",
-		RunE: UpdateVersionCommandRunE,
+		Use:     "version",
+		PreRunE: UpdateVersionCommandPreRunE,
+		RunE:    UpdateVersionCommandRunE,
 	}
 
+	command.Flags().StringVar(&cli.GitRemoteFlag, "remote", cli.DefaultGitRemote, "git remote to fetch from")
+
 	return command
 }
 
+// UpdateVersionCommandPreRunE runs before the command runs.
+// The remote flag overrides the git-remote flag only if it is used.
+// Returns an error if it fails.
+func UpdateVersionCommandPreRunE(cmd *cobra.Command, args []string) (err error) {
+	if !cmd.Flags().Changed("remote") {
+		return err
+	}
+
+	return viper.BindPFlag(cli.GitRemoteConfigKey, cmd.Flags().Lookup("remote"))
+}
+
 // UpdateVersionCommandRunE runs the commands.
 // Returns an error if it fails.
 func UpdateVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
 	log.Debug().Str("command", "v1.update-version").Msg("starting run...")
 
 	var updateOptions = &core.UpdateVersionOptions{
-		GitTagsPrefix: viper.GetString(cli.GitTagsPrefixConfigKey),
-		GitTagsSuffix: viper.GetString(cli.GitTagsSuffixConfigKey),
+		DryRun:     cli.DryRunFlag,
+		GitBackend: viper.GetString(cli.GitBackendConfigKey),
+		GitRemote:  viper.GetString(cli.GitRemoteConfigKey),
+		RepoPath:   cli.RepoFlag,
 	}
 
-	if err = core.UpdateVersion(updateOptions); err != nil {
+	log.Debug().Str("remote", updateOptions.GitRemote).Msg("options")
+
+	if err = core.UpdateVersion(cmd.Context(), updateOptions); err != nil {
 		err = cli.NewCommandError(err)
 	}
 
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/cli"
+	"github.com/restechnica/semverbot/pkg/core"
+)
+
+// NewVerifyVersionCommand creates a new verify version command.
+// Returns the new spf13/cobra command.
+func NewVerifyVersionCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:  "version",
+		RunE: VerifyVersionCommandRunE,
+	}
+
+	return command
+}
+
+// VerifyVersionCommandRunE runs the command.
+// Returns an error if the command fails or if the signature of the current version is invalid.
+func VerifyVersionCommandRunE(cmd *cobra.Command, args []string) (err error) {
+	log.Debug().Str("command", "v1.verify-version").Msg("starting run...")
+
+	var options = &core.VerifyVersionOptions{
+		DefaultVersion:  cli.DefaultVersion,
+		GitBackend:      viper.GetString(cli.GitBackendConfigKey),
+		GitTagsMerged:   viper.GetBool(cli.GitTagsMergedConfigKey),
+		GitTagsPrefix:   viper.GetString(cli.GitTagsPrefixConfigKey),
+		GitTagsProject:  viper.GetString(cli.GitTagsProjectConfigKey),
+		GitTagsSuffix:   viper.GetString(cli.GitTagsSuffixConfigKey),
+		GitTagsTemplate: viper.GetString(cli.GitTagsTemplateConfigKey),
+		RepoPath:        cli.RepoFlag,
+	}
+
+	log.Debug().
+		Str("default", options.DefaultVersion).
+		Bool("merged", options.GitTagsMerged).
+		Str("prefix", options.GitTagsPrefix).
+		Str("suffix", options.GitTagsSuffix).
+		Str("template", options.GitTagsTemplate).
+		Msg("options")
+
+	var output string
+
+	if output, err = core.VerifyVersion(cmd.Context(), options); err != nil {
+		err = cli.NewCommandError(err)
+	} else {
+		log.Info().Msg(strings.Trim(output, "\n"))
+	}
+
+	return err
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyCommand creates a new verify command.
+// Returns the new spf13/cobra command.
+func NewVerifyCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use: "verify",
+	}
+
+	command.AddCommand(NewVerifyVersionCommand())
+
+	return command
+}
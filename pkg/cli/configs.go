@@ -1,18 +1,72 @@
 package cli
 
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/ext/viperx"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
 const (
+	// CIGitHubStepSummaryConfigKey key for the GitHub Actions step summary config.
+	CIGitHubStepSummaryConfigKey = "ci.github.step-summary"
+
+	// CIGitLabDotenvConfigKey key for the GitLab CI dotenv report config.
+	CIGitLabDotenvConfigKey = "ci.gitlab.dotenv"
+
+	// CIOutputsConfigKey key for the CI outputs config.
+	CIOutputsConfigKey = "ci.outputs"
+
+	// ConstraintsConfigKey key for the branch version constraints config.
+	ConstraintsConfigKey = "constraints"
+
+	// ExtendsConfigKey key for the config files a config file extends.
+	ExtendsConfigKey = viperx.ExtendsKey
+
+	// GitBackendConfigKey key for the git backend config.
+	GitBackendConfigKey = "git.backend"
+
 	// GitConfigEmailConfigKey key for the git email config.
 	GitConfigEmailConfigKey = "git.config.email"
 
 	// GitConfigNameConfigKey key for the git name config.
 	GitConfigNameConfigKey = "git.config.name"
 
+	// GitPushRemotesConfigKey key for the git push remotes config.
+	GitPushRemotesConfigKey = "git.push.remotes"
+
+	// GitPushRetriesConfigKey key for the git push retries config.
+	GitPushRetriesConfigKey = "git.push.retries"
+
+	// GitRemoteConfigKey key for the git remote config.
+	GitRemoteConfigKey = "git.remote"
+
+	// GitTagsMergedConfigKey key for the git tags merged config.
+	GitTagsMergedConfigKey = "git.tags.merged"
+
 	// GitTagsPrefixConfigKey key for the git tags prefix config.
 	GitTagsPrefixConfigKey = "git.tags.prefix"
 
+	// GitTagsProjectConfigKey key for the git tags project config.
+	GitTagsProjectConfigKey = "git.tags.project"
+
+	// GitTagsSignConfigKey key for the git tags sign config.
+	GitTagsSignConfigKey = "git.tags.sign"
+
+	// GitTagsSigningFormatConfigKey key for the git tags signing format config.
+	GitTagsSigningFormatConfigKey = "git.tags.signing-format"
+
+	// GitTagsSigningKeyConfigKey key for the git tags signing key config.
+	GitTagsSigningKeyConfigKey = "git.tags.signing-key"
+
 	// GitTagsSuffixConfigKey key for the git tags suffix config.
 	GitTagsSuffixConfigKey = "git.tags.suffix"
 
+	// GitTagsTemplateConfigKey key for the git tags template config.
+	GitTagsTemplateConfigKey = "git.tags.template"
+
 	// ModeConfigKey key for the mode config.
 	ModeConfigKey = "mode"
 
@@ -25,3 +79,45 @@ const (
 	// SemverMapConfigKey key for the semver map config.
 	SemverMapConfigKey = "semver"
 )
+
+// GetSemverLevelConfigKey gets the key of a level of the semver map config, e.g. semver.minor for minor.
+// Returns the config key.
+func GetSemverLevelConfigKey(level string) string {
+	return SemverMapConfigKey + "." + level
+}
+
+// GetSemverMap gets the semver map config level by level,
+// so a flag or environment variable of a single level overrides only that level of the config file.
+// Returns the semver map with the configured levels.
+func GetSemverMap() semver.Map {
+	var semverMap = semver.Map{}
+
+	for _, level := range semverLevels {
+		if key := GetSemverLevelConfigKey(level); viper.IsSet(key) {
+			semverMap[level] = GetStringSlice(key)
+		}
+	}
+
+	return semverMap
+}
+
+// GetStringSlice gets a list config value like viper.GetStringSlice, but splits a single string on commas instead of whitespace,
+// so an environment variable like SBOT_GIT_PUSH_REMOTES=origin,upstream configures a list the same way a flag does.
+// Returns the values without surrounding whitespace and without empty values.
+func GetStringSlice(key string) (values []string) {
+	var value, isString = viper.Get(key).(string)
+
+	if !isString {
+		return viper.GetStringSlice(key)
+	}
+
+	values = []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
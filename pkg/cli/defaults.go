@@ -1,30 +1,53 @@
 package cli
 
-import (
-	"fmt"
-
-	"github.com/restechnica/semverbot/internal"
-)
+import "github.com/restechnica/semverbot/internal"
 
 var (
 	// DefaultAdditionalConfigFilePaths additional default relative filepaths to the config file.
 	DefaultAdditionalConfigFilePaths = []string{".sbot.toml", ".semverbot/config.toml", ".sbot/config.toml"}
 
+	// DefaultCIGitLabDotenv the default relative filepath to the GitLab CI dotenv report.
+	DefaultCIGitLabDotenv = internal.DefaultCIGitLabDotenv
+
 	// DefaultConfigFilePath the default relative filepath to the config file.
 	DefaultConfigFilePath = internal.DefaultConfigFilePath
 
+	// DefaultGitBackend the default backend used to interact with git.
+	DefaultGitBackend = internal.DefaultGitBackend
+
 	// DefaultGitBranchDelimiters the default delimiters used by the git-branch mode.
 	DefaultGitBranchDelimiters = internal.DefaultGitBranchDelimiters
 
 	// DefaultGitCommitDelimiters the default delimiters used by the git-commit mode.
 	DefaultGitCommitDelimiters = internal.DefaultGitCommitDelimiters
 
+	// DefaultGitConfigEmail the default git user.email for new configs.
+	DefaultGitConfigEmail = internal.DefaultGitConfigEmail
+
+	// DefaultGitConfigName the default git user.name for new configs.
+	DefaultGitConfigName = internal.DefaultGitConfigName
+
+	// DefaultGitPushRetries the default number of times a release is retried when a remote rejects the pushed tag.
+	DefaultGitPushRetries = internal.DefaultGitPushRetries
+
+	// DefaultGitRef the default ref to predict and release versions for.
+	DefaultGitRef = internal.DefaultGitRef
+
+	// DefaultGitRemote the default remote to fetch tags from and push tags to.
+	DefaultGitRemote = internal.DefaultGitRemote
+
+	// DefaultGitTagsMerged the default for new configs whether to only consider tags merged into the ref.
+	DefaultGitTagsMerged = internal.DefaultGitTagsMerged
+
 	// DefaultGitTagsPrefix the default prefix prepended to git tags.
 	DefaultGitTagsPrefix = internal.DefaultGitTagsPrefix
 
 	// DefaultGitTagsSuffix the default suffix prepended to git tags.
 	DefaultGitTagsSuffix = internal.DefaultGitTagsSuffix
 
+	// DefaultGitTagsTemplate the default template to render versions into git tags.
+	DefaultGitTagsTemplate = internal.DefaultGitTagsTemplate
+
 	// DefaultMode the default mode for incrementing versions.
 	DefaultMode = internal.DefaultMode
 
@@ -32,39 +55,14 @@ var (
 	DefaultVersion = internal.DefaultVersion
 )
 
-func GetDefaultConfig() string {
-	const template = `mode = "%s"
-
-[git]
-
-[git.config]
-email = "semverbot@github.com"
-name = "semverbot"
-
-[git.tags]
-prefix = "%s"
-suffix = "%s"
-
-[semver]
-patch = ["fix", "bug"]
-minor = ["feature"]
-major = ["release"]
-
-[modes]
-
-[modes.git-branch]
-delimiters = "%s"
-
-[modes.git-commit]
-delimiters = "%s"
-`
+// GetDefaultInitConfig gets the config which sbot init starts from.
+// Returns the default init config.
+func GetDefaultInitConfig() internal.InitConfig {
+	return internal.DefaultInitConfig()
+}
 
-	return fmt.Sprintf(
-		template,
-		DefaultMode,
-		DefaultGitTagsPrefix,
-		DefaultGitTagsSuffix,
-		DefaultGitBranchDelimiters,
-		DefaultGitCommitDelimiters,
-	)
+// GetDefaultConfig gets the config which sbot init writes without prompts or existing tags.
+// Returns the default config in TOML.
+func GetDefaultConfig() string {
+	return GetDefaultInitConfig().String()
 }
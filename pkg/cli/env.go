@@ -0,0 +1,15 @@
+package cli
+
+import "strings"
+
+// EnvPrefix the prefix of the environment variables which override config properties.
+const EnvPrefix = "SBOT"
+
+// EnvKeyReplacer replaces the characters of config keys which are not allowed in environment variable names.
+var EnvKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// GetEnvVarName gets the name of the environment variable which overrides a config property, e.g. SBOT_GIT_TAGS_PREFIX.
+// Returns the environment variable name.
+func GetEnvVarName(key string) string {
+	return strings.ToUpper(EnvPrefix + "_" + EnvKeyReplacer.Replace(key))
+}
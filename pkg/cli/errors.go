@@ -1,5 +1,7 @@
 package cli
 
+import "fmt"
+
 func NewCommandError(err error) CommandError {
 	return CommandError{Err: err}
 }
@@ -11,3 +13,14 @@ type CommandError struct {
 func (e CommandError) Error() string {
 	return e.Err.Error()
 }
+
+// ConfigError an invalid config property, with the file and the key path of the property.
+type ConfigError struct {
+	File    string
+	Key     string
+	Message string
+}
+
+func (e ConfigError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.File, e.Key, e.Message)
+}
@@ -1,8 +1,11 @@
 package exec
 
 import (
+	"context"
 	"errors"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/restechnica/semverbot/pkg/cli"
 	"github.com/restechnica/semverbot/pkg/cli/commands"
@@ -10,14 +13,19 @@ import (
 )
 
 // Run will execute the CLI root command.
+// The command context is cancelled on an interrupt or termination signal, which stops any running git command.
 func Run() (err error) {
+	var ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	var command = commands.NewRootCommand()
 
-	if err = command.Execute(); err != nil {
+	if err = command.ExecuteContext(ctx); err != nil {
 		if errors.As(err, &cli.CommandError{}) {
 			log.Error().Err(err).Msg("")
 		}
 
+		stop()
 		os.Exit(1)
 	}
 
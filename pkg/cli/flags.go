@@ -1,15 +1,136 @@
 package cli
 
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/internal/util"
+)
+
+// unflaggedConfigKeys the keys of the config properties which can not be overridden by a flag,
+// because their values are lists of objects or config files.
+var unflaggedConfigKeys = []string{ConstraintsConfigKey, ExtendsConfigKey}
+
 var (
+	// BranchFlag a flag which indicates the branch to match constraints against, instead of the branch of the git ref.
+	BranchFlag string
+
 	// ConfigFlag a flag which configures the config file location.
 	ConfigFlag string
 
+	// ConstraintFlag a flag which indicates a range of versions, e.g. ">=1.2 <2" or "^1.4".
+	ConstraintFlag string
+
 	// DebugFlag a flag which sets the log level verbosity to Debug if true
 	DebugFlag bool
 
+	// DryRunFlag a flag which indicates that git operations which modify anything should only be logged.
+	DryRunFlag bool
+
+	// GitPushRemotesFlag a flag which overrides the git remotes to push tags to.
+	GitPushRemotesFlag []string
+
+	// GitRefFlag a flag which indicates the git ref, e.g. a commit hash or branch, to predict and release a version for.
+	GitRefFlag string
+
+	// GitRemoteFlag a flag which overrides the git remote to fetch tags from.
+	GitRemoteFlag string
+
+	// LimitFlag a flag which limits the number of listed versions. There is no limit if 0.
+	LimitFlag int
+
+	// LevelFlag a flag which indicates the semver level to increment, e.g. patch, minor or major.
+	LevelFlag string
+
 	// ModeFlag a flag which indicates the semver mode to increment the current version with.
 	ModeFlag string
 
+	// NonInteractiveFlag a flag which indicates that the inferred and default answers should be used without prompting.
+	NonInteractiveFlag bool
+
+	// OutputTemplateFlag a flag which indicates the template to print versions with in the text output, e.g. {{.Major}}.{{.Minor}}.
+	OutputTemplateFlag string
+
+	// OutputFlag a flag which indicates the output format, e.g. text, json, yaml or env.
+	OutputFlag string
+
+	// PredictFlag a flag which indicates whether the predicted version should be checked instead of the current version.
+	PredictFlag bool
+
+	// PrereleaseFlag a flag which indicates the prerelease to append to a version, e.g. rc.1.
+	PrereleaseFlag string
+
+	// PrereleasesFlag a flag which indicates whether prerelease versions should be listed as well.
+	PrereleasesFlag bool
+
+	// PushFlag a flag which indicates whether a released, deleted or retracted version should be pushed in the same step.
+	PushFlag bool
+
+	// ReasonFlag a flag which indicates why a version is retracted.
+	ReasonFlag string
+
+	// RepoFlag a flag which configures the path sbot runs in, like git -C. The current working directory is used if empty.
+	RepoFlag string
+
+	// TimeoutFlag a flag which configures how long a command may run before it and any running git command are stopped.
+	TimeoutFlag time.Duration
+
 	// VerboseFlag a flag which increases log level verbosity to Info if true
 	VerboseFlag bool
 )
+
+// AddConfigFlags adds a flag for each config property which can be overridden on the command line.
+// The flags are named after the config keys, see GetConfigFlagName, e.g. --git-tags-prefix for git.tags.prefix.
+func AddConfigFlags(flags *pflag.FlagSet) {
+	flags.Bool(GetConfigFlagName(CIGitHubStepSummaryConfigKey), false, "write the versions to the GitHub Actions step summary")
+	flags.String(GetConfigFlagName(CIGitLabDotenvConfigKey), DefaultCIGitLabDotenv, "GitLab CI dotenv report to write the versions to")
+	flags.Bool(GetConfigFlagName(CIOutputsConfigKey), false, "write the versions to the outputs of the detected CI platform")
+	flags.String(GetConfigFlagName(GitBackendConfigKey), DefaultGitBackend, "backend to interact with git, either cli or native")
+	flags.String(GetConfigFlagName(GitConfigEmailConfigKey), "", "git user.email to set if it is not set")
+	flags.String(GetConfigFlagName(GitConfigNameConfigKey), "", "git user.name to set if it is not set")
+	flags.StringSlice(GetConfigFlagName(GitPushRemotesConfigKey), []string{}, "git remotes to push tags to")
+	flags.Int(GetConfigFlagName(GitPushRetriesConfigKey), DefaultGitPushRetries, "number of times a release is retried when a remote rejects the pushed tag")
+	flags.String(GetConfigFlagName(GitRemoteConfigKey), DefaultGitRemote, "git remote to fetch tags from")
+	flags.Bool(GetConfigFlagName(GitTagsMergedConfigKey), false, "only consider tags merged into the git ref")
+	flags.String(GetConfigFlagName(GitTagsPrefixConfigKey), DefaultGitTagsPrefix, "prefix of git tags")
+	flags.String(GetConfigFlagName(GitTagsProjectConfigKey), "", "project of git tags in a monorepo")
+	flags.Bool(GetConfigFlagName(GitTagsSignConfigKey), false, "sign git tags")
+	flags.String(GetConfigFlagName(GitTagsSigningFormatConfigKey), "", "format to sign git tags with, e.g. openpgp, ssh or x509")
+	flags.String(GetConfigFlagName(GitTagsSigningKeyConfigKey), "", "key to sign git tags with")
+	flags.String(GetConfigFlagName(GitTagsSuffixConfigKey), DefaultGitTagsSuffix, "suffix of git tags")
+	flags.String(GetConfigFlagName(GitTagsTemplateConfigKey), DefaultGitTagsTemplate, "template to render versions into git tags")
+	flags.StringVarP(&ModeFlag, GetConfigFlagName(ModeConfigKey), "m", DefaultMode, "sbot mode")
+	flags.String(GetConfigFlagName(ModesGitBranchDelimitersConfigKey), DefaultGitBranchDelimiters, "delimiters of the git-branch mode")
+	flags.String(GetConfigFlagName(ModesGitCommitDelimitersConfigKey), DefaultGitCommitDelimiters, "delimiters of the git-commit mode")
+
+	for _, level := range semverLevels {
+		flags.StringSlice(GetConfigFlagName(GetSemverLevelConfigKey(level)), []string{}, "keywords which increment the "+level+" level")
+	}
+}
+
+// BindConfigFlags binds the flags of AddConfigFlags to their config properties.
+// Flags take precedence over environment variables and config files, but only if they are used.
+// Returns an error if any flag is missing.
+func BindConfigFlags(flags *pflag.FlagSet) (err error) {
+	var keys []string
+
+	for _, key := range configKeys {
+		if !util.SliceContainsString(unflaggedConfigKeys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, level := range semverLevels {
+		keys = append(keys, GetSemverLevelConfigKey(level))
+	}
+
+	for _, key := range keys {
+		if err = viper.BindPFlag(key, flags.Lookup(GetConfigFlagName(key))); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
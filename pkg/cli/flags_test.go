@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+func TestBindConfigFlags(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.SetEnvPrefix(EnvPrefix)
+	viper.SetEnvKeyReplacer(EnvKeyReplacer)
+	viper.AutomaticEnv()
+
+	viper.SetDefault(GitRemoteConfigKey, "origin")
+	viper.SetDefault(GitTagsPrefixConfigKey, "v")
+	viper.SetDefault(GitTagsSuffixConfigKey, "")
+	viper.SetDefault(ModesGitBranchDelimitersConfigKey, "/")
+
+	viper.SetConfigType("toml")
+	require.NoError(t, viper.ReadConfig(strings.NewReader("[git]\nremote = \"upstream\"\n[git.tags]\nprefix = \"file-\"\nsuffix = \"-file\"")))
+
+	t.Setenv("SBOT_GIT_TAGS_SUFFIX", "-env")
+	t.Setenv("SBOT_MODES_GIT_BRANCH_DELIMITERS", "-")
+
+	var flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddConfigFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--git-tags-prefix", "flag-"}))
+	require.NoError(t, BindConfigFlags(flags))
+
+	type Test struct {
+		Name string
+		Key  string
+		Want string
+	}
+
+	var tests = []Test{
+		{Name: "FlagOverridesFile", Key: GitTagsPrefixConfigKey, Want: "flag-"},
+		{Name: "EnvOverridesFile", Key: GitTagsSuffixConfigKey, Want: "-env"},
+		{Name: "EnvOverridesDefaultOfKeyWithDash", Key: ModesGitBranchDelimitersConfigKey, Want: "-"},
+		{Name: "UnusedFlagDoesNotOverrideFile", Key: GitRemoteConfigKey, Want: "upstream"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got = viper.GetString(test.Key)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
+
+func TestGetSemverMap(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.SetDefault(SemverMapConfigKey, semver.Map{})
+
+	viper.SetConfigType("toml")
+	require.NoError(t, viper.ReadConfig(strings.NewReader("[semver]\nminor = [\"feature\"]\nmajor = [\"release\"]")))
+
+	var flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddConfigFlags(flags)
+	require.NoError(t, flags.Parse([]string{"--semver-minor", "feat,enhancement"}))
+	require.NoError(t, BindConfigFlags(flags))
+
+	var want = semver.Map{"minor": {"feat", "enhancement"}, "major": {"release"}}
+	var got = GetSemverMap()
+
+	assert.Equal(t, want, got, `want: "%v, got: "%v"`, want, got)
+}
+
+func TestGetSemverMap_SplitsEnvOnCommas(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.SetEnvPrefix(EnvPrefix)
+	viper.SetEnvKeyReplacer(EnvKeyReplacer)
+	viper.AutomaticEnv()
+
+	t.Setenv("SBOT_SEMVER_MINOR", "feature,feat")
+
+	var flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	AddConfigFlags(flags)
+	require.NoError(t, flags.Parse([]string{}))
+	require.NoError(t, BindConfigFlags(flags))
+
+	var want = semver.Map{"minor": {"feature", "feat"}}
+	var got = GetSemverMap()
+
+	assert.Equal(t, want, got, `want: "%v, got: "%v"`, want, got)
+}
+
+func TestGetStringSlice(t *testing.T) {
+	type Test struct {
+		Env   string
+		Flags []string
+		File  string
+		Name  string
+		Want  []string
+	}
+
+	var tests = []Test{
+		{Name: "SplitEnvOnCommas", Env: "origin,upstream", Want: []string{"origin", "upstream"}},
+		{Name: "TrimEnvValues", Env: " origin , upstream,", Want: []string{"origin", "upstream"}},
+		{Name: "ReturnSingleEnvValue", Env: "origin", Want: []string{"origin"}},
+		{Name: "ReturnFileList", File: "[git.push]\nremotes = [\"origin\", \"upstream\"]", Want: []string{"origin", "upstream"}},
+		{Name: "SplitFileStringOnCommas", File: "[git.push]\nremotes = \"origin,upstream\"", Want: []string{"origin", "upstream"}},
+		{Name: "ReturnFlagList", Env: "mirror", Flags: []string{"--git-push-remotes", "origin,upstream"}, Want: []string{"origin", "upstream"}},
+		{Name: "ReturnEmptyIfNotSet", Want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+
+			viper.SetEnvPrefix(EnvPrefix)
+			viper.SetEnvKeyReplacer(EnvKeyReplacer)
+			viper.AutomaticEnv()
+
+			if test.Env != "" {
+				t.Setenv("SBOT_GIT_PUSH_REMOTES", test.Env)
+			}
+
+			viper.SetConfigType("toml")
+			require.NoError(t, viper.ReadConfig(strings.NewReader(test.File)))
+
+			var flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+			AddConfigFlags(flags)
+			require.NoError(t, flags.Parse(test.Flags))
+			require.NoError(t, BindConfigFlags(flags))
+
+			var got = GetStringSlice(GitPushRemotesConfigKey)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package cli
+
+const (
+	// EnvFormat output format for KEY=value lines which can be sourced by a shell.
+	EnvFormat = "env"
+
+	// JSONFormat output format for machine-readable JSON.
+	JSONFormat = "json"
+
+	// TextFormat output format for plain text, one value per line.
+	TextFormat = "text"
+
+	// YAMLFormat output format for machine-readable YAML.
+	YAMLFormat = "yaml"
+)
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var unsafeEnvValue = regexp.MustCompile(`[^A-Za-z0-9_@%+=:,./-]`)
+
+// ValidateOutputFormat validates an output format.
+// Returns an error if the format is not text, json, yaml or env.
+func ValidateOutputFormat(format string) (err error) {
+	switch format {
+	case TextFormat, JSONFormat, YAMLFormat, EnvFormat:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format '%s', expected %s, %s, %s or %s", format, TextFormat, JSONFormat, YAMLFormat, EnvFormat)
+	}
+}
+
+// ValidateOutputTemplate validates that an output template is only used with the text output format.
+// Returns an error if a template is used with another output format.
+func ValidateOutputTemplate(format string, template string) (err error) {
+	if template != "" && format != TextFormat {
+		return fmt.Errorf("a template can only be used with the %s output format, not %s", TextFormat, format)
+	}
+
+	return err
+}
+
+// WriteOutput writes a value in an output format.
+// The text is written as is for the text format, nothing is written if it is empty.
+// The json, yaml and env formats are derived from the JSON encoding of the value, keeping the order of its fields.
+// Returns an error if the format is invalid or the value could not be encoded.
+func WriteOutput(writer io.Writer, format string, value any, text string) (err error) {
+	if format == TextFormat {
+		if text != "" {
+			_, err = fmt.Fprintln(writer, text)
+		}
+
+		return err
+	}
+
+	if err = ValidateOutputFormat(format); err != nil {
+		return err
+	}
+
+	var encoded []byte
+
+	if encoded, err = json.MarshalIndent(value, "", "  "); err != nil {
+		return err
+	}
+
+	if format == JSONFormat {
+		_, err = fmt.Fprintln(writer, string(encoded))
+		return err
+	}
+
+	var node yaml.Node
+
+	// JSON is valid YAML, decoding it into a node keeps the order of the fields
+	if err = yaml.Unmarshal(encoded, &node); err != nil {
+		return err
+	}
+
+	if format == EnvFormat {
+		writeEnvNode(writer, "SBOT", &node)
+		return err
+	}
+
+	resetNodeStyle(&node)
+
+	if encoded, err = yaml.Marshal(&node); err != nil {
+		return err
+	}
+
+	_, err = writer.Write(encoded)
+
+	return err
+}
+
+// resetNodeStyle resets the JSON flow and quoting style of a node and its children to the default YAML style.
+func resetNodeStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		resetNodeStyle(child)
+	}
+}
+
+// writeEnvNode writes a node as KEY=value lines.
+// Nested keys are joined with an underscore, lists of scalars are joined with a comma and other lists are indexed.
+func writeEnvNode(writer io.Writer, key string, node *yaml.Node) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			writeEnvNode(writer, key, child)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			var name = strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(node.Content[i].Value))
+			writeEnvNode(writer, key+"_"+name, node.Content[i+1])
+		}
+	case yaml.SequenceNode:
+		if isScalarSequence(node) {
+			var values = make([]string, len(node.Content))
+
+			for i, child := range node.Content {
+				values[i] = child.Value
+			}
+
+			fmt.Fprintf(writer, "%s=%s\n", key, quoteEnvValue(strings.Join(values, ",")))
+			return
+		}
+
+		for i, child := range node.Content {
+			writeEnvNode(writer, key+"_"+strconv.Itoa(i), child)
+		}
+	case yaml.ScalarNode:
+		var value = node.Value
+
+		if node.Tag == "!!null" {
+			value = ""
+		}
+
+		fmt.Fprintf(writer, "%s=%s\n", key, quoteEnvValue(value))
+	}
+}
+
+// isScalarSequence checks whether a sequence node only contains scalars.
+// Returns true if it does.
+func isScalarSequence(node *yaml.Node) bool {
+	for _, child := range node.Content {
+		if child.Kind != yaml.ScalarNode {
+			return false
+		}
+	}
+
+	return true
+}
+
+// quoteEnvValue quotes a value so a POSIX shell reads it literally.
+// Returns the value as is if it contains no characters a shell would interpret.
+func quoteEnvValue(value string) string {
+	if !unsafeEnvValue.MatchString(value) {
+		return value
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteOutput(t *testing.T) {
+	type Value struct {
+		Version string   `json:"version"`
+		Tag     string   `json:"tag,omitempty"`
+		Remotes []string `json:"remotes,omitempty"`
+	}
+
+	type Test struct {
+		Format string
+		Name   string
+		Text   string
+		Value  any
+		Want   string
+	}
+
+	var value = Value{Version: "1.2.0", Tag: "v1.2.0", Remotes: []string{"origin", "backup"}}
+
+	var tests = []Test{
+		{Name: "WriteText", Format: TextFormat, Value: value, Text: "1.2.0", Want: "1.2.0\n"},
+		{Name: "WriteNothingWithoutText", Format: TextFormat, Value: value, Want: ""},
+		{Name: "WriteJSON", Format: JSONFormat, Value: value, Want: "{\n  \"version\": \"1.2.0\",\n  \"tag\": \"v1.2.0\",\n  \"remotes\": [\n    \"origin\",\n    \"backup\"\n  ]\n}\n"},
+		{Name: "WriteYAMLInFieldOrder", Format: YAMLFormat, Value: value, Want: "version: 1.2.0\ntag: v1.2.0\nremotes:\n    - origin\n    - backup\n"},
+		{Name: "WriteEnv", Format: EnvFormat, Value: value, Want: "SBOT_VERSION=1.2.0\nSBOT_TAG=v1.2.0\nSBOT_REMOTES=origin,backup\n"},
+		{Name: "WriteEnvWithIndexedObjects", Format: EnvFormat, Value: []Value{{Version: "1.2.0"}, {Version: "1.0.0"}}, Want: "SBOT_0_VERSION=1.2.0\nSBOT_1_VERSION=1.0.0\n"},
+		{Name: "WriteEnvWithQuotedValues", Format: EnvFormat, Value: map[string]string{"reason": "it's broken"}, Want: "SBOT_REASON='it'\\''s broken'\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			var err = WriteOutput(&buffer, test.Format, test.Value, test.Text)
+			var got = buffer.String()
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidFormat", func(t *testing.T) {
+		var buffer bytes.Buffer
+		var err = WriteOutput(&buffer, "xml", value, "1.2.0")
+
+		assert.Error(t, err)
+		assert.Empty(t, buffer.String())
+	})
+}
@@ -0,0 +1,13 @@
+package cli
+
+import "path/filepath"
+
+// ResolveRepoPath resolves a relative path against the repository path of the repo flag.
+// Returns the path unchanged if it is absolute or if the repo flag is not used.
+func ResolveRepoPath(path string) string {
+	if RepoFlag == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(RepoFlag, path)
+}
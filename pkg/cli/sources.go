@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/pkg/ext/viperx"
+)
+
+const (
+	// DefaultSource the source of config properties which are not configured anywhere else.
+	DefaultSource = "default"
+
+	// EnvSource the source of config properties which are configured by an environment variable.
+	EnvSource = "env"
+
+	// FileSource the source of config properties which are configured in the config file.
+	FileSource = "file"
+
+	// FlagSource the source of config properties which are configured by a flag.
+	FlagSource = "flag"
+)
+
+// ConfigValue the effective value of a config property and where it came from.
+// The origin is the flag, environment variable or config file of the source, if any.
+type ConfigValue struct {
+	Key    string `json:"key"`
+	Value  any    `json:"value"`
+	Source string `json:"source"`
+	Origin string `json:"origin,omitempty"`
+}
+
+// String returns a string representation of an instance, e.g. git.tags.prefix = "v" (file .semverbot.toml).
+func (value ConfigValue) String() string {
+	var encoded, err = json.Marshal(value.Value)
+
+	if err != nil {
+		encoded = []byte(fmt.Sprint(value.Value))
+	}
+
+	if value.Origin == "" {
+		return fmt.Sprintf("%s = %s (%s)", value.Key, encoded, value.Source)
+	}
+
+	return fmt.Sprintf("%s = %s (%s %s)", value.Key, encoded, value.Source, value.Origin)
+}
+
+// GetConfigFlagName gets the name of the flag which overrides a config property, e.g. git-tags-prefix for git.tags.prefix.
+// Returns the flag name.
+func GetConfigFlagName(key string) string {
+	return strings.ReplaceAll(key, ".", "-")
+}
+
+// GetConfigValues gets the effective value of each config property with its source, ordered by key.
+// The config file of a value is the file which sets it last, e.g. the extending file if both files set it.
+// The sources are checked in the order of precedence: flags, environment variables, the config file and defaults.
+// Empty environment variables are ignored, like viper ignores them.
+// Returns the config values.
+func GetConfigValues(flags *pflag.FlagSet) (values []ConfigValue) {
+	var keys = viper.AllKeys()
+
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		// skip maps whose values are listed by their own keys, e.g. semver for semver.minor
+		if i+1 < len(keys) && strings.HasPrefix(keys[i+1], key+".") {
+			continue
+		}
+
+		var value = ConfigValue{Key: key, Value: viper.Get(key), Source: DefaultSource}
+		var flagName = GetConfigFlagName(key)
+		var envVarName = GetEnvVarName(key)
+		var flag = flags.Lookup(flagName)
+
+		if flag != nil && flag.Changed {
+			value.Source, value.Origin = FlagSource, "--"+flagName
+		} else if os.Getenv(envVarName) != "" {
+			value.Source, value.Origin = EnvSource, envVarName
+
+			// lists are split on commas like GetStringSlice does, instead of showing the raw environment variable
+			if flag != nil && flag.Value.Type() == "stringSlice" {
+				value.Value = GetStringSlice(key)
+			}
+		} else if viper.InConfig(key) {
+			value.Source, value.Origin = FileSource, viperx.GetConfigFile(key)
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfigValues(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.SetEnvPrefix(EnvPrefix)
+	viper.SetEnvKeyReplacer(EnvKeyReplacer)
+	viper.AutomaticEnv()
+
+	viper.SetDefault(GitPushRemotesConfigKey, []string{})
+	viper.SetDefault(GitRemoteConfigKey, "origin")
+	viper.SetDefault(GitTagsPrefixConfigKey, "v")
+	viper.SetDefault(GitTagsSuffixConfigKey, "")
+	viper.SetDefault(ModeConfigKey, "auto")
+	viper.SetDefault(SemverMapConfigKey, map[string][]string{})
+
+	viper.SetConfigType("toml")
+	require.NoError(t, viper.ReadConfig(strings.NewReader("mode = \"patch\"\n[git]\nremote = \"upstream\"\n[semver]\nminor = [\"feature\"]")))
+
+	t.Setenv("SBOT_GIT_TAGS_PREFIX", "release-")
+	t.Setenv("SBOT_GIT_TAGS_SUFFIX", "")
+	t.Setenv("SBOT_GIT_PUSH_REMOTES", "origin,upstream")
+
+	var flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("mode", "", "")
+	flags.StringSlice("git-push-remotes", []string{}, "")
+	require.NoError(t, flags.Set("mode", "major"))
+	require.NoError(t, viper.BindPFlag(ModeConfigKey, flags.Lookup("mode")))
+
+	var want = []ConfigValue{
+		{Key: GitPushRemotesConfigKey, Value: []string{"origin", "upstream"}, Source: EnvSource, Origin: "SBOT_GIT_PUSH_REMOTES"},
+		{Key: GitRemoteConfigKey, Value: "upstream", Source: FileSource},
+		{Key: GitTagsPrefixConfigKey, Value: "release-", Source: EnvSource, Origin: "SBOT_GIT_TAGS_PREFIX"},
+		{Key: GitTagsSuffixConfigKey, Value: "", Source: DefaultSource},
+		{Key: ModeConfigKey, Value: "major", Source: FlagSource, Origin: "--mode"},
+		{Key: "semver.minor", Value: []any{"feature"}, Source: FileSource},
+	}
+
+	var got = GetConfigValues(flags)
+
+	assert.Equal(t, want, got, `want: "%v, got: "%v"`, want, got)
+}
+
+func TestConfigValue_String(t *testing.T) {
+	type Test struct {
+		Name  string
+		Value ConfigValue
+		Want  string
+	}
+
+	var tests = []Test{
+		{Name: "FormatDefault", Value: ConfigValue{Key: "git.tags.prefix", Value: "v", Source: DefaultSource}, Want: `git.tags.prefix = "v" (default)`},
+		{Name: "FormatWithOrigin", Value: ConfigValue{Key: "git.push.retries", Value: 5, Source: EnvSource, Origin: "SBOT_GIT_PUSH_RETRIES"}, Want: `git.push.retries = 5 (env SBOT_GIT_PUSH_RETRIES)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got = test.Value.String()
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/restechnica/semverbot/internal/util"
+	"github.com/restechnica/semverbot/pkg/ext/viperx"
+	"github.com/restechnica/semverbot/pkg/modes"
+)
+
+// configKeys the keys of all supported config properties, except for the levels of the semver map.
+var configKeys = []string{
+	CIGitHubStepSummaryConfigKey,
+	CIGitLabDotenvConfigKey,
+	CIOutputsConfigKey,
+	ConstraintsConfigKey,
+	ExtendsConfigKey,
+	GitBackendConfigKey,
+	GitConfigEmailConfigKey,
+	GitConfigNameConfigKey,
+	GitPushRemotesConfigKey,
+	GitPushRetriesConfigKey,
+	GitRemoteConfigKey,
+	GitTagsMergedConfigKey,
+	GitTagsPrefixConfigKey,
+	GitTagsProjectConfigKey,
+	GitTagsSignConfigKey,
+	GitTagsSigningFormatConfigKey,
+	GitTagsSigningKeyConfigKey,
+	GitTagsSuffixConfigKey,
+	GitTagsTemplateConfigKey,
+	ModeConfigKey,
+	ModesGitBranchDelimitersConfigKey,
+	ModesGitCommitDelimitersConfigKey,
+}
+
+// semverLevels the levels supported by the semver map.
+var semverLevels = []string{modes.Patch, modes.Minor, modes.Major}
+
+// supportedModes the modes supported by the mode config.
+var supportedModes = []string{modes.Auto, modes.GitBranch, modes.GitCommit, modes.Patch, modes.Minor, modes.Major}
+
+// ConfigValidation the result of validating a config file.
+type ConfigValidation struct {
+	File  string `json:"file"`
+	Valid bool   `json:"valid"`
+}
+
+// ValidateConfigFiles validates the config properties of each config file, see ValidateConfigFile.
+// Returns a ConfigError for each invalid property, ordered by file and key, or an error if any file could not be read.
+func ValidateConfigFiles(paths []string) (errs []error, err error) {
+	for _, path := range paths {
+		var fileErrs []error
+
+		if fileErrs, err = ValidateConfigFile(path); err != nil {
+			return errs, err
+		}
+
+		errs = append(errs, fileErrs...)
+	}
+
+	return errs, err
+}
+
+// ValidateConfigFile validates the config properties of a config file.
+// Returns a ConfigError for each invalid property, ordered by key, or an error if the file could not be read.
+func ValidateConfigFile(path string) (errs []error, err error) {
+	var config *viper.Viper
+
+	if config, err = viperx.ReadConfig(path); err != nil {
+		return errs, err
+	}
+
+	return ValidateConfig(config, path), err
+}
+
+// ValidateConfig validates the config properties of a config file which was read into a viper instance.
+// It rejects unknown keys, unsupported semver levels and modes, keywords which are mapped to multiple semver levels
+// and empty delimiters.
+// Returns a ConfigError for each invalid property, ordered by key.
+func ValidateConfig(config *viper.Viper, file string) (errs []error) {
+	var keys = config.AllKeys()
+	var levels = map[string]string{}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var newError = func(format string, args ...any) {
+			errs = append(errs, ConfigError{File: file, Key: key, Message: fmt.Sprintf(format, args...)})
+		}
+
+		if level, isLevel := strings.CutPrefix(key, SemverMapConfigKey+"."); isLevel {
+			if !util.SliceContainsString(semverLevels, level) {
+				newError("invalid semver level '%s', expected %s", level, strings.Join(semverLevels, ", "))
+				continue
+			}
+
+			for _, keyword := range config.GetStringSlice(key) {
+				if other, exists := levels[keyword]; exists && other != level {
+					newError("keyword '%s' is mapped to both %s and %s", keyword, other, level)
+					continue
+				}
+
+				levels[keyword] = level
+			}
+
+			continue
+		}
+
+		if !util.SliceContainsString(configKeys, key) {
+			newError("unknown key")
+			continue
+		}
+
+		switch key {
+		case ModeConfigKey:
+			if mode := config.GetString(key); !util.SliceContainsString(supportedModes, mode) {
+				newError("invalid mode '%s', expected %s", mode, strings.Join(supportedModes, ", "))
+			}
+		case ModesGitBranchDelimitersConfigKey, ModesGitCommitDelimitersConfigKey:
+			if config.GetString(key) == "" {
+				newError("delimiters must not be empty")
+			}
+		}
+	}
+
+	return errs
+}
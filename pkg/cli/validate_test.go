@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newConfig reads a TOML config into a new viper instance.
+func newConfig(t *testing.T, text string) *viper.Viper {
+	var config = viper.New()
+	config.SetConfigType("toml")
+	require.NoError(t, config.ReadConfig(strings.NewReader(text)))
+	return config
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Run("AcceptDefaultConfig", func(t *testing.T) {
+		var got = ValidateConfig(newConfig(t, GetDefaultConfig()), "config.toml")
+		assert.Empty(t, got)
+	})
+
+	type Test struct {
+		Name   string
+		Config string
+		Want   string
+	}
+
+	var tests = []Test{
+		{Name: "RejectUnknownKey", Config: "[git.tags]\nprefx = \"v\"", Want: "config.toml: git.tags.prefx: unknown key"},
+		{Name: "RejectInvalidMode", Config: `mode = "git-comit"`, Want: "config.toml: mode: invalid mode 'git-comit', expected auto, git-branch, git-commit, patch, minor, major"},
+		{Name: "RejectInvalidSemverLevel", Config: "[semver]\nminr = [\"feature\"]", Want: "config.toml: semver.minr: invalid semver level 'minr', expected patch, minor, major"},
+		{Name: "RejectDuplicateKeyword", Config: "[semver]\nminor = [\"fix\"]\npatch = [\"fix\"]", Want: "config.toml: semver.patch: keyword 'fix' is mapped to both minor and patch"},
+		{Name: "RejectEmptyDelimiters", Config: "[modes.git-commit]\ndelimiters = \"\"", Want: "config.toml: modes.git-commit.delimiters: delimiters must not be empty"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var errs = ValidateConfig(newConfig(t, test.Config), "config.toml")
+
+			require.Len(t, errs, 1)
+
+			var got = errs[0].Error()
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorForEachInvalidProperty", func(t *testing.T) {
+		var got = ValidateConfig(newConfig(t, "mode = \"none\"\ncolour = \"red\""), "config.toml")
+		assert.Len(t, got, 2)
+	})
+}
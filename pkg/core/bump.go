@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	blangsemver "github.com/blang/semver/v4"
+
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+type BumpVersionOptions struct {
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	Level           string
+	Prerelease      string
+}
+
+// BumpVersion increments a semver level of a version without a git repository, like the patch, minor and major modes do.
+// The version can be a tag, e.g. v1.2.0 for prefix v. A prerelease is appended to the incremented version, e.g. 1.3.0-rc.1.
+// Returns the incremented version and its tag or an error if the version, level or prerelease is invalid.
+func BumpVersion(ctx context.Context, version string, options *BumpVersionOptions) (result VersionResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var mode modes.Mode
+
+	switch options.Level {
+	case modes.Patch:
+		mode = modes.NewPatchMode()
+	case modes.Minor:
+		mode = modes.NewMinorMode()
+	case modes.Major:
+		mode = modes.NewMajorMode()
+	default:
+		return result, fmt.Errorf("invalid level '%s', expected %s, %s or %s", options.Level, modes.Patch, modes.Minor, modes.Major)
+	}
+
+	var parsed blangsemver.Version
+
+	if parsed, err = parseVersionOrTag(template, version); err != nil {
+		return result, err
+	}
+
+	var next string
+
+	if next, err = mode.Increment(ctx, parsed.String()); err != nil {
+		return result, err
+	}
+
+	if options.Prerelease != "" {
+		next = fmt.Sprintf("%s-%s", next, options.Prerelease)
+
+		if _, err = blangsemver.Parse(next); err != nil {
+			return result, fmt.Errorf("invalid prerelease '%s': %w", options.Prerelease, err)
+		}
+	}
+
+	result = VersionResult{
+		Version:         next,
+		Tag:             template.Render(next),
+		PreviousVersion: parsed.String(),
+		Level:           options.Level,
+	}
+
+	return result, nil
+}
+
+// parseVersionOrTag parses a version given by a user, which is either a tag matching the template or a version, e.g. v1.0.0 or 1.0.0.
+// Returns the parsed version or an error if it is neither a tag nor a valid semver version.
+func parseVersionOrTag(template semver.Template, value string) (version blangsemver.Version, err error) {
+	if version, err = template.Parse(value); err == nil {
+		return version, nil
+	}
+
+	if version, err = semver.Parse(value); err != nil {
+		return version, fmt.Errorf("invalid version '%s': %w", value, err)
+	}
+
+	return version, nil
+}
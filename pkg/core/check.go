@@ -0,0 +1,23 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+// CheckVersion checks whether a version satisfies a range of versions, e.g. "^1.4" or ">=1.2 <2".
+// Returns an error if the version or the range is invalid or if the version does not satisfy the range.
+func CheckVersion(version string, constraint string) (err error) {
+	var satisfied bool
+
+	if satisfied, err = semver.Satisfies(version, constraint); err != nil {
+		return err
+	}
+
+	if !satisfied {
+		return fmt.Errorf("version %s does not satisfy the range '%s'", version, constraint)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/restechnica/semverbot/pkg/ci"
+)
+
+type WriteCIOutputsOptions struct {
+	DryRun            bool
+	GitHubStepSummary bool
+	GitLabDotenv      string
+	Outputs           bool
+}
+
+// WriteCIOutputs writes the version, previous version, level and tag of a result as outputs of the CI platform sbot runs on.
+// Nothing is written if the outputs are disabled, if no supported CI platform is detected or on a dry run,
+// since the outputs are files, e.g. the GitLab CI dotenv report in the repository.
+// Returns an error if the outputs could not be written.
+func WriteCIOutputs(result VersionResult, options *WriteCIOutputsOptions) (err error) {
+	if !options.Outputs {
+		return err
+	}
+
+	var platform = ci.Detect(os.Getenv, ci.Options{
+		GitHubStepSummary: options.GitHubStepSummary,
+		GitLabDotenv:      options.GitLabDotenv,
+	})
+
+	if platform == nil {
+		return err
+	}
+
+	if options.DryRun {
+		log.Info().Str("platform", platform.String()).Msg("dry run, skipping ci outputs")
+		return err
+	}
+
+	log.Info().Str("platform", platform.String()).Msg("writing ci outputs...")
+
+	return platform.WriteOutputs(ci.Outputs{
+		Version:         result.Version,
+		PreviousVersion: result.PreviousVersion,
+		Level:           result.Level,
+		Tag:             result.Tag,
+	})
+}
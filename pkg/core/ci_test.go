@@ -0,0 +1,38 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCIOutputs(t *testing.T) {
+	type Test struct {
+		Name    string
+		Options WriteCIOutputsOptions
+		Want    bool
+	}
+
+	var tests = []Test{
+		{Name: "WriteOutputsIfEnabled", Options: WriteCIOutputsOptions{Outputs: true}, Want: true},
+		{Name: "SkipOutputsIfDisabled", Options: WriteCIOutputsOptions{Outputs: false}, Want: false},
+		{Name: "SkipOutputsOnDryRun", Options: WriteCIOutputsOptions{DryRun: true, Outputs: true}, Want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Setenv("GITHUB_ACTIONS", "")
+			t.Setenv("GITLAB_CI", "true")
+
+			var options = test.Options
+			options.GitLabDotenv = filepath.Join(t.TempDir(), "sbot.env")
+
+			assert.NoError(t, WriteCIOutputs(VersionResult{Version: "1.0.0"}, &options))
+
+			var _, err = os.Stat(options.GitLabDotenv)
+			assert.Equal(t, test.Want, err == nil, `want: "%t", got: "%s"`, test.Want, err)
+		})
+	}
+}
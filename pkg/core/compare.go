@@ -0,0 +1,43 @@
+package core
+
+import (
+	blangsemver "github.com/blang/semver/v4"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+type CompareVersionsOptions struct {
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+}
+
+// CompareVersions compares two versions by semver precedence without a git repository.
+// The versions can be tags, e.g. v1.2.0 for prefix v. Build metadata is ignored, prereleases precede their release.
+// Returns -1 if a precedes b, 0 if they are equal or 1 if a follows b, or an error if either version is invalid.
+func CompareVersions(a string, b string, options *CompareVersionsOptions) (result ComparisonResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var versionA, versionB blangsemver.Version
+
+	if versionA, err = parseVersionOrTag(template, a); err != nil {
+		return result, err
+	}
+
+	if versionB, err = parseVersionOrTag(template, b); err != nil {
+		return result, err
+	}
+
+	result = ComparisonResult{
+		A:          versionA.String(),
+		B:          versionB.String(),
+		Comparison: versionA.Compare(versionB),
+	}
+
+	return result, nil
+}
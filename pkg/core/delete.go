@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	blangsemver "github.com/blang/semver/v4"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+type DeleteVersionOptions struct {
+	DryRun          bool
+	GitBackend      string
+	GitPushRemotes  []string
+	GitRemote       string
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	Push            bool
+	RepoPath        string
+}
+
+// DeleteVersion deletes the tag of a version, as well as the companion tag if the version is retracted.
+// The tags are deleted on the configured push remotes first if enabled, so that a failure leaves the local tags intact.
+// Falls back to the default remote if no push remotes are configured.
+// Returns an error if the version is invalid or if deleting any of the tags went wrong.
+func DeleteVersion(ctx context.Context, version string, options *DeleteVersionOptions) (err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return err
+	}
+
+	if version, err = normalizeVersion(version); err != nil {
+		return err
+	}
+
+	var gitAPI = newGitAPI(options.GitBackend, options.RepoPath, options.DryRun)
+	var versionAPI = versions.NewAPI(template, "", gitAPI)
+	var retracted bool
+
+	if retracted, err = versionAPI.IsRetracted(ctx, version); err != nil {
+		return err
+	}
+
+	if options.Push {
+		var remotes = getPushRemotes(options.GitPushRemotes, options.GitRemote)
+
+		if err = versionAPI.DeleteRemoteVersion(ctx, version, remotes); err != nil {
+			return err
+		}
+
+		if retracted {
+			if err = versionAPI.DeleteRemoteRetraction(ctx, version, remotes); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = versionAPI.DeleteVersion(ctx, version); err != nil {
+		return err
+	}
+
+	if retracted {
+		return versionAPI.DeleteRetraction(ctx, version)
+	}
+
+	return nil
+}
+
+// normalizeVersion normalizes a version given by a user, e.g. v1.0.0 to 1.0.0.
+// Returns the normalized version or an error if the version is not a valid semver version.
+func normalizeVersion(version string) (normalized string, err error) {
+	var parsed blangsemver.Version
+
+	if parsed, err = semver.Parse(version); err != nil {
+		return normalized, fmt.Errorf("invalid version '%s': %w", version, err)
+	}
+
+	return parsed.String(), nil
+}
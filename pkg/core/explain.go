@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/restechnica/semverbot/pkg/ci"
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+// VersionExplanation explains how a version is predicted.
+// The version is the latest version, including a retracted one, which the prediction increments.
+// The tag is empty if the current version is the default version because no tag was found.
+// The prediction differs from the version of the mode if a constraint clamped it,
+// or if it skipped versions which are already released on a ref which is not merged.
+type VersionExplanation struct {
+	Version    string            `json:"version"`
+	Tag        string            `json:"tag,omitempty"`
+	Retracted  bool              `json:"retracted,omitempty"`
+	Commit     string            `json:"commit,omitempty"`
+	Mode       modes.Explanation `json:"mode"`
+	Clamped    bool              `json:"clamped,omitempty"`
+	Skipped    []string          `json:"skipped,omitempty"`
+	Prediction string            `json:"prediction,omitempty"`
+}
+
+// ExplainVersion explains how a version is predicted, like PredictVersion does.
+// Returns the explanation, which is as complete as possible, or an error if the prediction failed or is outside the version range.
+func ExplainVersion(ctx context.Context, options *PredictVersionOptions) (explanation VersionExplanation, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return explanation, err
+	}
+
+	return explainVersion(ctx, template, options)
+}
+
+// explainVersion predicts a version based on a modes.Mode and explains the prediction.
+// Returns the explanation or an error if the prediction failed or is outside the version range.
+func explainVersion(ctx context.Context, template semver.Template, options *PredictVersionOptions) (explanation VersionExplanation, err error) {
+	var gitAPI = getGitAPI(options.GitAPI, options.GitBackend, options.RepoPath, false)
+
+	var gitBranchMode = modes.NewGitBranchMode(options.GitBranchDelimiters, options.SemverMap, gitAPI, options.Ref)
+	var gitCommitMode = modes.NewGitCommitMode(options.GitCommitDelimiters, options.SemverMap, gitAPI, options.Ref)
+
+	var versionAPI = versions.NewAPI(template, getMergedRef(options.GitTagsMerged, options.Ref), gitAPI)
+
+	// retracted versions are included, since their tags still exist and the next version has to be bigger
+	explanation.Version, explanation.Tag = getBaseVersion(ctx, versionAPI, options.DefaultVersion)
+
+	if explanation.Tag != "" {
+		if explanation.Retracted, err = versionAPI.IsRetracted(ctx, explanation.Version); err != nil {
+			return explanation, err
+		}
+	}
+
+	explanation.Commit = getCommitHash(ctx, gitAPI, options.Ref)
+
+	var modeAPI = modes.NewAPI(gitBranchMode, gitCommitMode)
+	var mode = modeAPI.SelectMode(options.Mode)
+
+	log.Info().Msg("predicting version...")
+
+	// the context is checked since modes might have fallen back to a different semver level because of the context
+	explanation.Mode = modes.Explain(ctx, mode, explanation.Version)
+
+	if err = explanation.Mode.Err; err == nil {
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		return explanation, err
+	}
+
+	log.Info().Msg(explanation.Mode.Version)
+
+	var branch string
+
+	if len(options.Constraints) > 0 {
+		if branch, err = getConstraintBranch(ctx, gitAPI, options.Ref, options.Branch); err != nil {
+			return explanation, err
+		}
+	}
+
+	if explanation.Prediction, err = versionAPI.ConstrainVersion(ctx, branch, explanation.Version, explanation.Mode.Version, options.Constraints); err != nil {
+		return explanation, err
+	}
+
+	explanation.Clamped = explanation.Prediction != explanation.Mode.Version
+
+	var level = explanation.Mode.Detected
+
+	if explanation.Clamped {
+		level = modes.Patch
+	}
+
+	if explanation.Prediction, explanation.Skipped, err = versionAPI.SkipReleasedVersions(ctx, explanation.Prediction, modeAPI.SelectMode(level)); err != nil || len(explanation.Skipped) == 0 {
+		return explanation, err
+	}
+
+	// the version after the released versions has to be within the range as well, clamping it would lead back to them
+	var constrained string
+
+	if constrained, err = versionAPI.ConstrainVersion(ctx, branch, explanation.Prediction, explanation.Prediction, options.Constraints); err == nil && constrained != explanation.Prediction {
+		err = fmt.Errorf("version %s is outside the range of branch '%s'", explanation.Prediction, branch)
+	}
+
+	return explanation, err
+}
+
+// getConstraintBranch gets the branch to match constraints against, which is the given branch if any, or else the branch of a ref.
+// A detached HEAD falls back to the branch of the CI pipeline, since CI platforms usually check out a detached HEAD.
+// No constraint matches if there is no branch at all, which is logged as a warning.
+// Returns the branch or an error if something went wrong with git.
+func getConstraintBranch(ctx context.Context, gitAPI git.API, ref string, branch string) (name string, err error) {
+	if branch != "" {
+		return branch, err
+	}
+
+	if name, err = gitAPI.GetBranchName(ctx, ref); err != nil {
+		return name, err
+	}
+
+	if name = strings.TrimSpace(name); name != "" && name != git.HEAD {
+		return name, err
+	}
+
+	if name == git.HEAD {
+		if branch = ci.DetectBranch(os.Getenv); branch != "" {
+			log.Debug().Str("branch", branch).Msg("using the branch of the ci pipeline for the detached HEAD")
+			return branch, err
+		}
+	}
+
+	log.Warn().Str("ref", ref).Msg("ref is not on a branch, e.g. a detached HEAD, so no constraint applies unless a branch is given")
+
+	return name, err
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/restechnica/semverbot/internal/mocks"
+	"github.com/restechnica/semverbot/pkg/git"
+)
+
+func TestGetConstraintBranch(t *testing.T) {
+	type Test struct {
+		Branch    string
+		Env       map[string]string
+		Name      string
+		RefBranch string
+		Want      string
+	}
+
+	var tests = []Test{
+		{Name: "ReturnGivenBranch", Branch: "release/1.4.x", RefBranch: "main\n", Want: "release/1.4.x"},
+		{Name: "ReturnBranchOfRef", RefBranch: "release/1.4.x\n", Want: "release/1.4.x"},
+		{
+			Name:      "ReturnBranchOfGitLabPipelineIfDetached",
+			Env:       map[string]string{"GITLAB_CI": "true", "CI_COMMIT_BRANCH": "release/1.4.x"},
+			RefBranch: "HEAD\n",
+			Want:      "release/1.4.x",
+		},
+		{
+			Name:      "ReturnBranchOfGitHubWorkflowIfDetached",
+			Env:       map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_REF_TYPE": "branch", "GITHUB_REF_NAME": "release/1.4.x"},
+			RefBranch: "HEAD\n",
+			Want:      "release/1.4.x",
+		},
+		{Name: "ReturnHEADIfDetachedOutsideCI", RefBranch: "HEAD\n", Want: "HEAD"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, key := range []string{"GITHUB_ACTIONS", "GITLAB_CI"} {
+				t.Setenv(key, "")
+			}
+
+			for key, value := range test.Env {
+				t.Setenv(key, value)
+			}
+
+			var gitAPI = mocks.NewMockGitAPI()
+			gitAPI.On("GetBranchName", git.HEAD).Return(test.RefBranch, nil)
+
+			var got, err = getConstraintBranch(context.Background(), gitAPI, git.HEAD, test.Branch)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+type FormatVersionOptions struct {
+	GitTagsPrefix string
+	GitTagsSuffix string
+	Template      string
+}
+
+// FormatVersion renders the version of a result with an output template, e.g. {{.Major}}.{{.Minor}} for a Docker tag.
+// See semver.VersionFields for the supported fields.
+// Returns the version as is if there is no template, the rendered version or an error if the template is invalid.
+func FormatVersion(result VersionResult, options *FormatVersionOptions) (formatted string, err error) {
+	if options.Template == "" {
+		return result.Version, err
+	}
+
+	var fields semver.VersionFields
+
+	if fields, err = semver.NewVersionFields(result.Version, result.Tag, result.Commit, options.GitTagsPrefix, options.GitTagsSuffix); err != nil {
+		return formatted, err
+	}
+
+	return semver.Format(options.Template, fields)
+}
@@ -1,18 +1,96 @@
 package core
 
 import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
 	"github.com/restechnica/semverbot/pkg/versions"
 )
 
 type GetVersionOptions struct {
-	GitTagPrefix   string
-	GitTagSuffix   string
-	DefaultVersion string
+	GitAPI          git.API
+	GitBackend      string
+	GitTagPrefix    string
+	GitTagSuffix    string
+	DefaultVersion  string
+	GitTagsMerged   bool
+	GitTagsProject  string
+	GitTagsTemplate string
+	RepoPath        string
+}
+
+// GetVersion gets the current version with its tag and the commit it was released on.
+// Returns the current version or an error if the tag template is invalid or if the context is done,
+// since the version might have fallen back to the default.
+func GetVersion(ctx context.Context, options *GetVersionOptions) (result VersionResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagPrefix, options.GitTagSuffix, options.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var gitAPI = getGitAPI(options.GitAPI, options.GitBackend, options.RepoPath, false)
+	var versionAPI = versions.NewAPI(template, getMergedRef(options.GitTagsMerged, git.HEAD), gitAPI)
+
+	result.Version, result.Tag = getVersion(ctx, versionAPI, options.DefaultVersion)
+
+	if result.Tag != "" {
+		result.Commit = getCommitHash(ctx, gitAPI, result.Tag)
+	}
+
+	return result, ctx.Err()
+}
+
+// getVersion gets the current version and its tag, like versions.API.GetVersionOrDefault does for the version alone.
+// Returns the current version and its tag, or the default version without a tag if no version was found.
+func getVersion(ctx context.Context, versionAPI versions.API, defaultVersion string) (version string, tag string) {
+	log.Info().Msg("getting version...")
+
+	tag, err := versionAPI.GetVersionTag(ctx)
+
+	return trimVersionTag(versionAPI.Template, tag, err, defaultVersion)
+}
+
+// getBaseVersion gets the version to predict the next version from and its tag, which includes retracted versions.
+// Returns the base version and its tag, or the default version without a tag if no version was found.
+func getBaseVersion(ctx context.Context, versionAPI versions.API, defaultVersion string) (version string, tag string) {
+	log.Info().Msg("getting base version...")
+
+	tag, err := versionAPI.GetBaseVersionTag(ctx)
+
+	return trimVersionTag(versionAPI.Template, tag, err, defaultVersion)
+}
+
+// trimVersionTag trims a version tag to its version, falling back to the default version if the tag could not be found.
+// Returns the version and its tag, or the default version without a tag if the tag could not be found or trimmed.
+func trimVersionTag(template semver.Template, tag string, err error, defaultVersion string) (version string, versionTag string) {
+	if err == nil {
+		version, err = semver.Trim(template, tag)
+	}
+
+	if err != nil {
+		log.Debug().Err(err).Msg("")
+		log.Warn().Msg("falling back to default version")
+		return defaultVersion, ""
+	}
+
+	log.Info().Msg(version)
+
+	return version, tag
 }
 
-// GetVersion gets the current version.
-// Returns the current version.
-func GetVersion(options *GetVersionOptions) string {
-	var versionAPI = versions.NewAPI(options.GitTagPrefix, options.GitTagSuffix)
-	return versionAPI.GetVersionOrDefault(options.DefaultVersion)
+// getCommitHash gets the hash of the commit a ref points to.
+// Returns the commit hash or an empty string if the ref does not point to a commit, e.g. in a repository without commits.
+func getCommitHash(ctx context.Context, gitAPI git.API, ref string) string {
+	var hash, err = gitAPI.GetCommitHash(ctx, ref)
+
+	if err != nil {
+		log.Debug().Err(err).Msgf("could not get the commit of %s", ref)
+	}
+
+	return strings.TrimSpace(hash)
 }
@@ -0,0 +1,39 @@
+package core
+
+import "github.com/restechnica/semverbot/pkg/git"
+
+// newGitAPI creates a new git.API for a backend and a repository path.
+// Returns the git.API, which only performs read operations if dry run is enabled.
+func newGitAPI(backend string, repoPath string, dryRun bool) git.API {
+	var gitAPI = git.NewAPI(backend, repoPath)
+
+	if dryRun {
+		return git.NewDryRun(gitAPI)
+	}
+
+	return gitAPI
+}
+
+// getGitAPI gets the git.API to use, which is the given git.API if any, e.g. when sbot is embedded, or else a new git.API.
+// Returns the git.API, which only performs read operations if dry run is enabled.
+func getGitAPI(gitAPI git.API, backend string, repoPath string, dryRun bool) git.API {
+	if gitAPI == nil {
+		return newGitAPI(backend, repoPath, dryRun)
+	}
+
+	if dryRun {
+		return git.NewDryRun(gitAPI)
+	}
+
+	return gitAPI
+}
+
+// getMergedRef gets the ref to restrict the versions to.
+// Returns the ref if only tags merged into it should be considered, otherwise an empty string.
+func getMergedRef(merged bool, ref string) string {
+	if merged {
+		return ref
+	}
+
+	return ""
+}
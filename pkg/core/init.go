@@ -1,24 +1,69 @@
 package core
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/rs/zerolog/log"
+
+	"github.com/restechnica/semverbot/internal"
+	"github.com/restechnica/semverbot/internal/util"
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
 )
 
+// commitConvention a convention for git commit messages, with the keywords and delimiters to detect semver levels.
+type commitConvention struct {
+	Name       string
+	Delimiters string
+	SemverMap  semver.Map
+}
+
+// keywordsConventionName the name of the convention to put the keywords of the semver map in git commit messages,
+// e.g. "[feature] add x", which is supported by the default config.
+const keywordsConventionName = "keywords, e.g. [feature] add x"
+
+// conventionalCommits the Conventional Commits convention, e.g. "feat(api): add x" or "fix!: remove y".
+// A breaking change is detected by the "!" after the type or the scope.
+var conventionalCommits = commitConvention{
+	Name:       "conventional commits, e.g. feat(api): add x",
+	Delimiters: "():",
+	SemverMap: semver.Map{
+		modes.Patch: {"fix", "perf"},
+		modes.Minor: {"feat"},
+		modes.Major: {"feat!", "fix!", "!"},
+	},
+}
+
 type InitOptions struct {
+	Config         internal.InitConfig
 	ConfigFilePath string
-	Config         string
+	GitAPI         git.API
+	Interactive    bool
+	Overwrite      bool
+	RepoPath       string
 }
 
-// Init initializes a config file with defaults.
-// It will prompt for confirmation before overwriting existing files.
-// Returns an error if something went wrong with IO operations or the prompt.
-func Init(options *InitOptions) (err error) {
-	var file *os.File
+// Init initializes a config file, based on the default config and the tags of the repository.
+// The prefix and suffix of the existing tags are inferred, if any.
+// If interactive, it asks which mode, commit convention and branch prefixes are used,
+// and prompts for confirmation before overwriting existing files.
+// Otherwise existing files are only overwritten if overwrite is enabled.
+// Returns an error if something went wrong with IO operations or the prompts,
+// or if an existing file can not be overwritten without confirmation.
+func Init(ctx context.Context, options *InitOptions) (err error) {
+	var config = options.Config
+
+	if _, err = os.Stat(options.ConfigFilePath); !os.IsNotExist(err) && !options.Overwrite {
+		if !options.Interactive {
+			return fmt.Errorf("config file %s already exists, refusing to overwrite it without confirmation", options.ConfigFilePath)
+		}
 
-	if _, err = os.Stat(options.ConfigFilePath); !os.IsNotExist(err) {
 		var prompt = &survey.Confirm{
 			Message: "Do you wish to overwrite your current config?",
 		}
@@ -34,11 +79,122 @@ func Init(options *InitOptions) (err error) {
 		}
 	}
 
-	if file, err = os.Create(options.ConfigFilePath); err != nil {
+	var gitAPI = getGitAPI(options.GitAPI, config.GitBackend, options.RepoPath, false)
+
+	if config, err = inferTagAffixes(ctx, gitAPI, config); err != nil {
+		log.Debug().Err(err).Msg("keeping the default prefix and suffix")
+	}
+
+	if options.Interactive {
+		if config, err = askInitConfig(config); err != nil {
+			return err
+		}
+	}
+
+	return writeConfigFile(options.ConfigFilePath, config.String())
+}
+
+// inferTagAffixes infers the prefix and suffix of the tags of a repository into a config, see semver.InferAffixes.
+// Returns the config with the inferred prefix and suffix, or the given config and an error if there are no tags to infer them from.
+func inferTagAffixes(ctx context.Context, gitAPI git.API, config internal.InitConfig) (inferred internal.InitConfig, err error) {
+	var output, prefix, suffix string
+
+	if output, err = gitAPI.GetTags(ctx); err != nil {
+		return config, err
+	}
+
+	if prefix, suffix, err = semver.InferAffixes(strings.Fields(output)); err != nil {
+		return config, err
+	}
+
+	log.Info().Str("prefix", prefix).Str("suffix", suffix).Msg("inferred from existing tags")
+
+	config.GitTagsPrefix, config.GitTagsSuffix = prefix, suffix
+
+	return config, err
+}
+
+// askInitConfig asks for the tag prefix and suffix, the mode, the commit convention and the branch prefixes of each semver level.
+// The answers of a config are offered as defaults. The keywords of the commit convention and the branch prefixes are combined,
+// because the semver map is used for both branch names and commit messages.
+// Returns the config with the answers or an error if a prompt failed.
+func askInitConfig(config internal.InitConfig) (answered internal.InitConfig, err error) {
+	if err = survey.AskOne(&survey.Input{Message: "Which prefix do your tags use?", Default: config.GitTagsPrefix}, &config.GitTagsPrefix); err != nil {
+		return config, err
+	}
+
+	if err = survey.AskOne(&survey.Input{Message: "Which suffix do your tags use?", Default: config.GitTagsSuffix}, &config.GitTagsSuffix); err != nil {
+		return config, err
+	}
+
+	var modePrompt = &survey.Select{
+		Message: "Which mode detects the semver level to increment?",
+		Options: []string{modes.Auto, modes.GitBranch, modes.GitCommit, modes.Patch, modes.Minor, modes.Major},
+		Default: config.Mode,
+		Help:    "auto tries the merged branch name, then the commit message, and increments the patch level as a last resort",
+	}
+
+	if err = survey.AskOne(modePrompt, &config.Mode); err != nil {
+		return config, err
+	}
+
+	var convention string
+
+	var conventionPrompt = &survey.Select{
+		Message: "Which commit convention does your team use?",
+		Options: []string{keywordsConventionName, conventionalCommits.Name},
+		Default: keywordsConventionName,
+	}
+
+	if err = survey.AskOne(conventionPrompt, &convention); err != nil {
+		return config, err
+	}
+
+	var commitSemverMap = config.SemverMap
+
+	if convention == conventionalCommits.Name {
+		commitSemverMap = conventionalCommits.SemverMap
+		config.ModesGitCommitDelimiters = conventionalCommits.Delimiters
+	}
+
+	var semverMap = semver.Map{}
+
+	for _, level := range []string{modes.Patch, modes.Minor, modes.Major} {
+		var answer string
+
+		var branchPrompt = &survey.Input{
+			Message: fmt.Sprintf("Which branch prefixes increment the %s level? (comma-separated)", level),
+			Default: strings.Join(config.SemverMap[level], ","),
+		}
+
+		if err = survey.AskOne(branchPrompt, &answer); err != nil {
+			return config, err
+		}
+
+		semverMap[level] = append([]string{}, commitSemverMap[level]...)
+
+		for _, prefix := range strings.Split(answer, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" && !util.SliceContainsString(semverMap[level], prefix) {
+				semverMap[level] = append(semverMap[level], prefix)
+			}
+		}
+	}
+
+	config.SemverMap = semverMap
+
+	return config, err
+}
+
+// writeConfigFile writes a config to a file, overwriting it if it exists.
+// Returns an error if something went wrong with IO operations.
+func writeConfigFile(path string, config string) (err error) {
+	var file *os.File
+
+	if file, err = os.Create(path); err != nil {
 		return err
 	}
 
-	if _, err = io.WriteString(file, options.Config); err != nil {
+	if _, err = io.WriteString(file, config); err != nil {
 		_ = file.Close()
 		return err
 	}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/restechnica/semverbot/internal"
+	"github.com/restechnica/semverbot/internal/fakes"
+)
+
+func TestInit(t *testing.T) {
+	const existing = "mode = \"git-commit\"\n"
+
+	var newOptions = func(t *testing.T, content string) *InitOptions {
+		var path = filepath.Join(t.TempDir(), ".semverbot.toml")
+
+		if content != "" {
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		}
+
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"api-v1.0.0", "api-v1.1.0"}
+
+		return &InitOptions{Config: internal.DefaultInitConfig(), ConfigFilePath: path, GitAPI: gitAPI}
+	}
+
+	var read = func(t *testing.T, path string) string {
+		var content, err = os.ReadFile(path)
+		assert.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("WriteConfigWithInferredPrefix", func(t *testing.T) {
+		var options = newOptions(t, "")
+		var config = options.Config
+		config.GitTagsPrefix = "api-v"
+
+		var err = Init(context.Background(), options)
+
+		assert.NoError(t, err)
+		assert.Equal(t, config.String(), read(t, options.ConfigFilePath))
+	})
+
+	t.Run("ReturnErrorOnExistingConfigWithoutTerminal", func(t *testing.T) {
+		var options = newOptions(t, existing)
+
+		var err = Init(context.Background(), options)
+
+		assert.ErrorContains(t, err, "already exists")
+		assert.Equal(t, existing, read(t, options.ConfigFilePath))
+	})
+
+	t.Run("OverwriteExistingConfigIfEnabled", func(t *testing.T) {
+		var options = newOptions(t, existing)
+		options.Overwrite = true
+
+		var err = Init(context.Background(), options)
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, existing, read(t, options.ConfigFilePath))
+	})
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+type ListVersionsOptions struct {
+	Constraint      string
+	GitBackend      string
+	GitTagsMerged   bool
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	Limit           int
+	Prereleases     bool
+	RepoPath        string
+}
+
+// ListVersions lists all versions with the details of their tags, sorted in descending order.
+// Returns the versions or an error if the tag template or the constraint is invalid or if the git tags could not be read.
+func ListVersions(ctx context.Context, options *ListVersionsOptions) (listed []versions.Version, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return listed, err
+	}
+
+	var gitAPI = git.NewAPI(options.GitBackend, options.RepoPath)
+	var versionAPI = versions.NewAPI(template, getMergedRef(options.GitTagsMerged, git.HEAD), gitAPI)
+
+	if listed, err = versionAPI.GetVersions(ctx); err != nil {
+		return listed, err
+	}
+
+	return versions.FilterVersions(listed, options.Constraint, options.Prereleases, options.Limit)
+}
@@ -1,33 +1,62 @@
 package core
 
 import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/modes"
 	"github.com/restechnica/semverbot/pkg/semver"
 	"github.com/restechnica/semverbot/pkg/versions"
 )
 
 type PredictVersionOptions struct {
+	Branch              string
+	Constraints         []versions.Constraint
 	DefaultVersion      string
+	GitAPI              git.API
+	GitBackend          string
 	GitBranchDelimiters string
 	GitCommitDelimiters string
+	GitTagsMerged       bool
 	GitTagsPrefix       string
+	GitTagsProject      string
 	GitTagsSuffix       string
+	GitTagsTemplate     string
 	Mode                string
+	Ref                 string
+	RepoPath            string
 	SemverMap           semver.Map
 }
 
 // PredictVersion predicts a version based on a modes.Mode and a modes.Map.
-// The modes.Map values will be matched against git information to detect which semver level to increment.
-// Returns the next version or an error if the prediction failed.
-func PredictVersion(options *PredictVersionOptions) (prediction string, err error) {
-	var gitBranchMode = modes.NewGitBranchMode(options.GitBranchDelimiters, options.SemverMap)
-	var gitCommitMode = modes.NewGitCommitMode(options.GitCommitDelimiters, options.SemverMap)
+// The modes.Map values will be matched against git information of a ref to detect which semver level to increment.
+// The prediction is constrained to the version range of the branch, or else of the branch of the ref, if any.
+// Returns the next version with the semver level it was incremented with or an error if the prediction failed or is outside the version range.
+func PredictVersion(ctx context.Context, options *PredictVersionOptions) (result VersionResult, err error) {
+	var template semver.Template
+	var explanation VersionExplanation
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	if explanation, err = explainVersion(ctx, template, options); err != nil {
+		return result, err
+	}
 
-	var versionAPI = versions.NewAPI(options.GitTagsPrefix, options.GitTagsSuffix)
-	var version = versionAPI.GetVersionOrDefault(options.DefaultVersion)
+	result = VersionResult{
+		Version:         explanation.Prediction,
+		Tag:             template.Render(explanation.Prediction),
+		PreviousVersion: explanation.Version,
+		Level:           explanation.Mode.Detected,
+		Mode:            explanation.Mode.Mode,
+		Commit:          explanation.Commit,
+	}
 
-	var modeAPI = modes.NewAPI(gitBranchMode, gitCommitMode)
-	var mode = modeAPI.SelectMode(options.Mode)
+	// a constraint clamps the prediction to a patch increment
+	if explanation.Clamped {
+		result.Level = modes.Patch
+	}
 
-	return versionAPI.PredictVersion(version, mode)
+	return result, nil
 }
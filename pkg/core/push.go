@@ -1,17 +1,62 @@
 package core
 
-import "github.com/restechnica/semverbot/pkg/versions"
+import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
 
 type PushVersionOptions struct {
-	DefaultVersion string
-	GitTagsPrefix  string
-	GitTagsSuffix  string
+	DefaultVersion  string
+	DryRun          bool
+	GitAPI          git.API
+	GitBackend      string
+	GitPushRemotes  []string
+	GitPushRetries  int
+	GitRemote       string
+	GitTagsMerged   bool
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	Ref             string
+	RepoPath        string
+}
+
+// PushVersion pushes the current version to the configured push remotes.
+// The current version is restricted to the tags merged into the ref if merged tags are configured, like when releasing.
+// Falls back to the default remote if no push remotes are configured.
+// Returns the pushed version and the remotes it was pushed to or an error if the push went wrong for any of the remotes.
+func PushVersion(ctx context.Context, options *PushVersionOptions) (result VersionResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var gitAPI = getGitAPI(options.GitAPI, options.GitBackend, options.RepoPath, options.DryRun)
+	var versionAPI = versions.NewAPI(template, getMergedRef(options.GitTagsMerged, options.Ref), gitAPI)
+	var remotes = getPushRemotes(options.GitPushRemotes, options.GitRemote)
+
+	result.Version = versionAPI.GetVersionOrDefault(ctx, options.DefaultVersion)
+	result.Tag = template.Render(result.Version)
+
+	if err = versionAPI.PushVersion(ctx, result.Version, remotes); err != nil {
+		return result, err
+	}
+
+	result.PushedRemotes = remotes
+
+	return result, nil
 }
 
-// PushVersion pushes the current version.
-// Returns an error if the push went wrong.
-func PushVersion(options *PushVersionOptions) (err error) {
-	var versionAPI = versions.NewAPI(options.GitTagsPrefix, options.GitTagsSuffix)
-	var version = versionAPI.GetVersionOrDefault(options.DefaultVersion)
-	return versionAPI.PushVersion(version)
+// getPushRemotes gets the remotes to push to, falling back to the default remote if no push remotes are configured.
+func getPushRemotes(pushRemotes []string, remote string) []string {
+	if len(pushRemotes) == 0 {
+		return []string{remote}
+	}
+
+	return pushRemotes
 }
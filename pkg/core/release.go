@@ -1,18 +1,126 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
 	"github.com/restechnica/semverbot/pkg/versions"
 )
 
-// ReleaseVersion releases a new version.
-// Returns an error if anything went wrong with the prediction or releasing.
-func ReleaseVersion(predictOptions *PredictVersionOptions) error {
-	var versionAPI = versions.NewAPI(predictOptions.GitTagsPrefix, predictOptions.GitTagsSuffix)
-	var predictedVersion, err = PredictVersion(predictOptions)
+type ReleaseVersionOptions struct {
+	DryRun               bool
+	GitTagsSign          bool
+	GitTagsSigningFormat string
+	GitTagsSigningKey    string
+}
+
+// ReleaseVersion releases a new version on the predicted ref, which is signed if configured.
+// Returns the released version or an error if anything went wrong with the prediction or releasing.
+func ReleaseVersion(ctx context.Context, predictOptions *PredictVersionOptions, releaseOptions *ReleaseVersionOptions) (result VersionResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(predictOptions.GitTagsTemplate, predictOptions.GitTagsPrefix, predictOptions.GitTagsSuffix, predictOptions.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var gitAPI = getGitAPI(predictOptions.GitAPI, predictOptions.GitBackend, predictOptions.RepoPath, releaseOptions.DryRun)
+	var versionAPI = versions.NewAPI(template, getMergedRef(predictOptions.GitTagsMerged, predictOptions.Ref), gitAPI)
+
+	if result, err = PredictVersion(ctx, predictOptions); err != nil {
+		return result, err
+	}
+
+	return result, releaseVersion(ctx, versionAPI, result.Version, predictOptions.Ref, releaseOptions)
+}
+
+// ReleaseAndPushVersion releases a new version and pushes it in one step.
+// The first remote decides whether the release succeeds. The local tag is deleted if pushing to it fails.
+// If the first remote rejects the tag because another release pushed the same version, the tags of that remote are fetched
+// and the version is predicted and released again, up to the configured number of retries. The fetched tags are not
+// necessarily merged into the ref, but the prediction skips the versions they already released.
+// The remaining remotes are pushed to once the first remote accepted the tag.
+// Returns the released version and the remotes it was pushed to
+// or an error if anything went wrong with the prediction, releasing or pushing, or if the context is done.
+func ReleaseAndPushVersion(
+	ctx context.Context,
+	predictOptions *PredictVersionOptions,
+	releaseOptions *ReleaseVersionOptions,
+	pushOptions *PushVersionOptions,
+) (result VersionResult, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(predictOptions.GitTagsTemplate, predictOptions.GitTagsPrefix, predictOptions.GitTagsSuffix, predictOptions.GitTagsProject); err != nil {
+		return result, err
+	}
+
+	var gitAPI = getGitAPI(predictOptions.GitAPI, predictOptions.GitBackend, predictOptions.RepoPath, releaseOptions.DryRun)
+	var versionAPI = versions.NewAPI(template, getMergedRef(predictOptions.GitTagsMerged, predictOptions.Ref), gitAPI)
+	var remotes = getPushRemotes(pushOptions.GitPushRemotes, pushOptions.GitRemote)
+
+	for attempt := 0; ; attempt++ {
+		if result, err = PredictVersion(ctx, predictOptions); err != nil {
+			return result, err
+		}
+
+		if err = releaseVersion(ctx, versionAPI, result.Version, predictOptions.Ref, releaseOptions); err != nil {
+			return result, err
+		}
+
+		if err = versionAPI.PushVersion(ctx, result.Version, remotes[:1]); err == nil {
+			result.PushedRemotes = remotes[:1]
+
+			if len(remotes) == 1 {
+				return result, nil
+			}
+
+			if err = versionAPI.PushVersion(ctx, result.Version, remotes[1:]); err == nil {
+				result.PushedRemotes = remotes
+			}
+
+			return result, err
+		}
+
+		// roll back without the context, the local tag should be deleted even if the release was cancelled
+		if deleteErr := versionAPI.DeleteVersion(context.Background(), result.Version); deleteErr != nil {
+			return result, errors.Join(err, fmt.Errorf("failed to roll back release: %w", deleteErr))
+		}
+
+		if !errors.As(err, &git.RejectedError{}) || attempt >= pushOptions.GitPushRetries {
+			return result, err
+		}
+
+		log.Warn().Err(err).Int("attempt", attempt+1).Msg("version already released, retrying...")
+
+		// the tags are fetched from the remote which rejected the tag, which is not necessarily the default remote
+		if err = versionAPI.FetchVersions(ctx, remotes[0]); err != nil {
+			return result, err
+		}
+	}
+}
 
-	if err != nil {
-		return err
+// releaseVersion releases a version on a ref, which is signed if configured.
+// Returns an error if the tag could not be created.
+func releaseVersion(
+	ctx context.Context,
+	versionAPI versions.API,
+	version string,
+	ref string,
+	releaseOptions *ReleaseVersionOptions,
+) error {
+	if releaseOptions.GitTagsSign {
+		return versionAPI.ReleaseSignedVersion(
+			ctx,
+			version,
+			ref,
+			releaseOptions.GitTagsSigningKey,
+			releaseOptions.GitTagsSigningFormat,
+		)
 	}
 
-	return versionAPI.ReleaseVersion(predictedVersion)
+	return versionAPI.ReleaseVersion(ctx, version, ref)
 }
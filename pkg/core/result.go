@@ -0,0 +1,21 @@
+package core
+
+// VersionResult the result of working with a version, e.g. getting, predicting, releasing or pushing it.
+// Fields which do not apply to the operation are empty, e.g. the tag of a default version which has no tag.
+type VersionResult struct {
+	Version         string   `json:"version"`
+	Tag             string   `json:"tag,omitempty"`
+	PreviousVersion string   `json:"previous_version,omitempty"`
+	Level           string   `json:"level,omitempty"`
+	Mode            string   `json:"mode,omitempty"`
+	Commit          string   `json:"commit,omitempty"`
+	PushedRemotes   []string `json:"pushed_remotes,omitempty"`
+}
+
+// ComparisonResult the result of comparing two versions.
+// The comparison is -1 if A precedes B, 0 if they are equal or 1 if A follows B.
+type ComparisonResult struct {
+	A          string `json:"a"`
+	B          string `json:"b"`
+	Comparison int    `json:"comparison"`
+}
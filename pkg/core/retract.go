@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+type RetractVersionOptions struct {
+	DryRun          bool
+	GitBackend      string
+	GitPushRemotes  []string
+	GitRemote       string
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	Push            bool
+	RepoPath        string
+}
+
+// RetractVersion retracts a version with a reason, which keeps the tag of the version but skips the version from then on.
+// The retraction is pushed to the configured push remotes if enabled.
+// Falls back to the default remote if no push remotes are configured.
+// Returns an error if the version is invalid or if retracting or pushing the retraction went wrong.
+func RetractVersion(ctx context.Context, version string, reason string, options *RetractVersionOptions) (err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return err
+	}
+
+	if version, err = normalizeVersion(version); err != nil {
+		return err
+	}
+
+	var gitAPI = newGitAPI(options.GitBackend, options.RepoPath, options.DryRun)
+	var versionAPI = versions.NewAPI(template, "", gitAPI)
+
+	if err = versionAPI.RetractVersion(ctx, version, reason); err != nil {
+		return err
+	}
+
+	if options.Push {
+		return versionAPI.PushRetraction(ctx, version, getPushRemotes(options.GitPushRemotes, options.GitRemote))
+	}
+
+	return nil
+}
@@ -1,17 +1,25 @@
 package core
 
 import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
 	"github.com/restechnica/semverbot/pkg/versions"
 )
 
 type UpdateVersionOptions struct {
-	GitTagsPrefix string
-	GitTagsSuffix string
+	DryRun     bool
+	GitAPI     git.API
+	GitBackend string
+	GitRemote  string
+	RepoPath   string
 }
 
-// UpdateVersion updates to the latest version.
+// UpdateVersion updates to the latest version by fetching tags from the configured remote.
 // Returns an error if updating the version went wrong.
-func UpdateVersion(updateOptions *UpdateVersionOptions) error {
-	var versionAPI = versions.NewAPI(updateOptions.GitTagsPrefix, updateOptions.GitTagsSuffix)
-	return versionAPI.UpdateVersion()
+func UpdateVersion(ctx context.Context, updateOptions *UpdateVersionOptions) error {
+	var gitAPI = getGitAPI(updateOptions.GitAPI, updateOptions.GitBackend, updateOptions.RepoPath, updateOptions.DryRun)
+	var versionAPI = versions.NewAPI(semver.Template{}, "", gitAPI)
+	return versionAPI.UpdateVersion(ctx, updateOptions.GitRemote)
 }
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"context"
+
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+type VerifyVersionOptions struct {
+	DefaultVersion  string
+	GitBackend      string
+	GitTagsMerged   bool
+	GitTagsPrefix   string
+	GitTagsProject  string
+	GitTagsSuffix   string
+	GitTagsTemplate string
+	RepoPath        string
+}
+
+// VerifyVersion verifies the signature of the current version.
+// Returns the verification output or an error if the signature is invalid or missing.
+func VerifyVersion(ctx context.Context, options *VerifyVersionOptions) (output string, err error) {
+	var template semver.Template
+
+	if template, err = semver.NewTemplate(options.GitTagsTemplate, options.GitTagsPrefix, options.GitTagsSuffix, options.GitTagsProject); err != nil {
+		return output, err
+	}
+
+	var gitAPI = git.NewAPI(options.GitBackend, options.RepoPath)
+	var versionAPI = versions.NewAPI(template, getMergedRef(options.GitTagsMerged, git.HEAD), gitAPI)
+	var version = versionAPI.GetVersionOrDefault(ctx, options.DefaultVersion)
+	return versionAPI.VerifyVersion(ctx, version)
+}
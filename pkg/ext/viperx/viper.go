@@ -1,6 +1,7 @@
 package viperx
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -8,8 +9,16 @@ import (
 	"github.com/spf13/viper"
 )
 
-// LoadConfig loads a configuration file.
-// Returns an error if it fails.
+// ExtendsKey the key of the configuration files a configuration file extends.
+const ExtendsKey = "extends"
+
+// configFiles the loaded configuration files in merge order, the extended files first.
+var configFiles []*viper.Viper
+
+// LoadConfig loads a configuration file, deep-merged over the configuration files it extends.
+// Extended files are merged in order and may extend other files themselves, values of the extending file win.
+// Relative paths of extended files are resolved against the directory of the extending file.
+// Returns an error if it fails, if any extended file could not be read or if files extend each other in a cycle.
 func LoadConfig(path string) (err error) {
 	viper.AddConfigPath(filepath.Dir(path))
 	viper.SetConfigName(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
@@ -17,7 +26,101 @@ func LoadConfig(path string) (err error) {
 
 	log.Debug().Str("path", path).Msg("loading config file...")
 
-	viper.AutomaticEnv()
+	if err = viper.ReadInConfig(); err != nil {
+		return err
+	}
+
+	if configFiles, err = readConfigChain(viper.ConfigFileUsed(), nil); err != nil {
+		return err
+	}
+
+	if len(configFiles) == 1 {
+		return err
+	}
+
+	var merged = viper.New()
+
+	for _, config := range configFiles {
+		log.Debug().Str("path", config.ConfigFileUsed()).Msg("merging config file...")
+
+		if err = merged.MergeConfigMap(config.AllSettings()); err != nil {
+			return err
+		}
+	}
+
+	return viper.MergeConfigMap(merged.AllSettings())
+}
+
+// ConfigFiles gets the paths of the loaded configuration files.
+// Returns the paths in merge order, the extended files first.
+func ConfigFiles() (paths []string) {
+	for _, config := range configFiles {
+		paths = append(paths, config.ConfigFileUsed())
+	}
+
+	return paths
+}
+
+// GetConfigFile gets the path of the loaded configuration file a key is set in.
+// Returns the path of the file whose value wins or an empty string if no file sets the key.
+func GetConfigFile(key string) string {
+	for i := len(configFiles) - 1; i >= 0; i-- {
+		if configFiles[i].InConfig(key) {
+			return configFiles[i].ConfigFileUsed()
+		}
+	}
+
+	return ""
+}
+
+// ReadConfig reads a configuration file into a new viper instance, without affecting the global configuration.
+// Returns the new viper instance or an error if it fails.
+func ReadConfig(path string) (config *viper.Viper, err error) {
+	config = viper.New()
+	config.SetConfigFile(path)
+
+	return config, config.ReadInConfig()
+}
+
+// readConfigChain reads a configuration file and the configuration files it extends, recursively.
+// The chain holds the absolute paths of the files which extend the file, to detect cycles.
+// Returns the files in merge order, the extended files first, or an error if any file could not be read
+// or if files extend each other in a cycle.
+func readConfigChain(path string, chain []string) (configs []*viper.Viper, err error) {
+	var absolute string
+
+	if absolute, err = filepath.Abs(path); err != nil {
+		return configs, err
+	}
+
+	// copy the chain, the chains of sibling files should not share their backing array
+	chain = append(append([]string{}, chain...), absolute)
+
+	for _, extending := range chain[:len(chain)-1] {
+		if extending == absolute {
+			return configs, fmt.Errorf("config files extend each other in a cycle: %s", strings.Join(chain, " -> "))
+		}
+	}
+
+	var config *viper.Viper
+
+	if config, err = ReadConfig(path); err != nil {
+		return configs, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	for _, extended := range config.GetStringSlice(ExtendsKey) {
+		if !filepath.IsAbs(extended) {
+			extended = filepath.Join(filepath.Dir(path), extended)
+		}
+
+		var extendedConfigs []*viper.Viper
+
+		if extendedConfigs, err = readConfigChain(extended, chain); err != nil {
+			return configs, err
+		}
+
+		configs = append(configs, extendedConfigs...)
+	}
 
-	return viper.ReadInConfig()
+	return append(configs, config), err
 }
@@ -0,0 +1,114 @@
+package viperx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfigFiles(t *testing.T, files map[string]string) (dir string) {
+	dir = t.TempDir()
+
+	for name, content := range files {
+		var path = filepath.Join(dir, name)
+
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	t.Cleanup(func() {
+		viper.Reset()
+		configFiles = nil
+	})
+
+	return dir
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("MergeExtendedFilesInOrder", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			"base.toml":       "mode = \"git-branch\"\n[git.tags]\nprefix = \"base-\"\nsuffix = \"-base\"\n",
+			"team.toml":       "[git.tags]\nprefix = \"team-\"\n",
+			".semverbot.toml": "extends = [\"base.toml\", \"team.toml\"]\n",
+		})
+
+		assert.NoError(t, LoadConfig(filepath.Join(dir, ".semverbot.toml")))
+
+		var got = viper.GetString("git.tags.prefix")
+		assert.Equal(t, "team-", got, `want: "%s, got: "%s"`, "team-", got)
+
+		got = viper.GetString("git.tags.suffix")
+		assert.Equal(t, "-base", got, `want: "%s, got: "%s"`, "-base", got)
+
+		got = viper.GetString("mode")
+		assert.Equal(t, "git-branch", got, `want: "%s, got: "%s"`, "git-branch", got)
+	})
+
+	t.Run("LocalValuesWin", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			"base.toml":       "mode = \"git-branch\"\n",
+			".semverbot.toml": "extends = [\"base.toml\"]\nmode = \"patch\"\n",
+		})
+
+		assert.NoError(t, LoadConfig(filepath.Join(dir, ".semverbot.toml")))
+
+		var got = viper.GetString("mode")
+		assert.Equal(t, "patch", got, `want: "%s, got: "%s"`, "patch", got)
+		assert.Equal(t, filepath.Join(dir, ".semverbot.toml"), GetConfigFile("mode"))
+	})
+
+	t.Run("DeepMergeNestedMaps", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			"base.toml":       "[semver]\nminor = [\"feature\"]\nmajor = [\"release\"]\n",
+			".semverbot.toml": "extends = [\"base.toml\"]\n[semver]\nminor = [\"feat\"]\n",
+		})
+
+		assert.NoError(t, LoadConfig(filepath.Join(dir, ".semverbot.toml")))
+
+		assert.Equal(t, []string{"feat"}, viper.GetStringSlice("semver.minor"))
+		assert.Equal(t, []string{"release"}, viper.GetStringSlice("semver.major"))
+		assert.Equal(t, filepath.Join(dir, "base.toml"), GetConfigFile("semver.major"))
+	})
+
+	t.Run("ResolveRelativePathsAgainstExtendingFile", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			"shared/base.toml": "mode = \"git-commit\"\n",
+			"shared/team.toml": "extends = [\"base.toml\"]\n",
+			".semverbot.toml":  "extends = [\"shared/team.toml\"]\n",
+		})
+
+		assert.NoError(t, LoadConfig(filepath.Join(dir, ".semverbot.toml")))
+
+		var got = viper.GetString("mode")
+		assert.Equal(t, "git-commit", got, `want: "%s, got: "%s"`, "git-commit", got)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "shared", "base.toml"),
+			filepath.Join(dir, "shared", "team.toml"),
+			filepath.Join(dir, ".semverbot.toml"),
+		}, ConfigFiles())
+	})
+
+	t.Run("ReturnErrorOnCycle", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			"base.toml":       "extends = [\".semverbot.toml\"]\n",
+			".semverbot.toml": "extends = [\"base.toml\"]\n",
+		})
+
+		var err = LoadConfig(filepath.Join(dir, ".semverbot.toml"))
+
+		assert.ErrorContains(t, err, "cycle")
+	})
+
+	t.Run("ReturnErrorOnMissingExtendedFile", func(t *testing.T) {
+		var dir = writeConfigFiles(t, map[string]string{
+			".semverbot.toml": "extends = [\"missing.toml\"]\n",
+		})
+
+		var err = LoadConfig(filepath.Join(dir, ".semverbot.toml"))
+
+		assert.ErrorContains(t, err, "missing.toml")
+	})
+}
@@ -1,16 +1,48 @@
 package git
 
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// HEAD the default ref to predict and release versions for.
+const HEAD = "HEAD"
+
 // API interface to interact with git.
 type API interface {
-	CreateAnnotatedTag(tag string) (err error)
-	FetchTags() (output string, err error)
-	FetchUnshallow() (output string, err error)
-	GetConfig(key string) (value string, err error)
-	GetLatestAnnotatedTag() (tag string, err error)
-	GetLatestCommitMessage() (message string, err error)
-	GetMergedBranchName() (name string, err error)
-	GetTags() (tags string, err error)
-	PushTag(tag string) (err error)
-	SetConfig(key string, value string) (err error)
-	SetConfigIfNotSet(key string, value string) (actual string, err error)
+	CreateAnnotatedTag(ctx context.Context, tag string, ref string, message string) (err error)
+	CreateSignedTag(ctx context.Context, tag string, ref string, signingKey string, signingFormat string) (err error)
+	DeleteRemoteTag(ctx context.Context, remote string, tag string) (err error)
+	DeleteTag(ctx context.Context, tag string) (err error)
+	FetchTags(ctx context.Context, remote string) (output string, err error)
+	FetchUnshallow(ctx context.Context) (output string, err error)
+	GetBranchName(ctx context.Context, ref string) (name string, err error)
+	GetCommitHash(ctx context.Context, ref string) (hash string, err error)
+	GetConfig(ctx context.Context, key string) (value string, err error)
+	GetLatestAnnotatedTag(ctx context.Context) (tag string, err error)
+	GetLatestCommitMessage(ctx context.Context, ref string) (message string, err error)
+	GetMergedBranchName(ctx context.Context, ref string) (name string, err error)
+	GetMergedTags(ctx context.Context, ref string) (tags string, err error)
+	GetTagDetails(ctx context.Context) (details string, err error)
+	GetTags(ctx context.Context) (tags string, err error)
+	PushTag(ctx context.Context, remote string, tag string) (err error)
+	SetConfig(ctx context.Context, key string, value string) (err error)
+	SetConfigIfNotSet(ctx context.Context, key string, value string) (actual string, err error)
+	VerifyTag(ctx context.Context, tag string) (output string, err error)
+}
+
+// NewAPI creates a new API for a backend, working on the repository in a directory.
+// The current working directory is used if the directory is empty.
+// Returns the API corresponding to the backend string, falling back to the CLI backend.
+func NewAPI(backend string, dir string) API {
+	switch backend {
+	case CLIBackend:
+		return NewCLI(dir)
+	case NativeBackend:
+		return NewNative(dir)
+	default:
+		log.Warn().Msgf("git backend '%s' invalid, falling back to %s backend", backend, CLIBackend)
+		return NewCLI(dir)
+	}
 }
@@ -1,94 +1,214 @@
 package git
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	cmder "github.com/restechnica/go-cmder/pkg"
 )
 
+// CLIBackend backend name for CLI.
+const CLIBackend = "cli"
+
 // CLI a git.API to interact with the git CLI.
 type CLI struct {
-	Commander cmder.Commander
+	Commander Commander
+
+	// Dir the directory git runs in, like git -C. The current working directory is used if empty.
+	Dir string
 }
 
-// NewCLI creates a new CLI with a commander to run git commands.
+// tagDetailsFormat the git for-each-ref format of GetTagDetails, annotated tags are dereferenced to get their commit.
+const tagDetailsFormat = "%(refname:strip=2) " +
+	"%(if)%(*objectname)%(then)%(*objectname)%(else)%(objectname)%(end) " +
+	"%(creatordate:iso-strict) " +
+	"%(if)%(*objectname)%(then)%(contents:subject)%(end)"
+
+// NewCLI creates a new CLI with a commander to run git commands in a directory.
 // Returns the new CLI.
-func NewCLI() CLI {
-	return CLI{Commander: cmder.NewExecCommander()}
+func NewCLI(dir string) CLI {
+	return CLI{Commander: NewExecCommander(), Dir: dir}
 }
 
-// CreateAnnotatedTag creates an annotated git tag.
+// CreateAnnotatedTag creates an annotated git tag with a message on a ref, e.g. HEAD or a commit hash.
 // Returns an error if the command fails.
-func (api CLI) CreateAnnotatedTag(tag string) (err error) {
-	return api.Commander.Run("git", "tag", "-a", tag, "-m", tag)
+func (api CLI) CreateAnnotatedTag(ctx context.Context, tag string, ref string, message string) (err error) {
+	return api.run(ctx, "tag", "-a", tag, "-m", message, ref)
 }
 
-// FetchTags fetches all tags from the remote origin.
+// CreateSignedTag creates a signed annotated git tag on a ref, e.g. HEAD or a commit hash.
+// The default signing key is used if no signing key is given.
+// The signing format, e.g. openpgp or ssh, overrides the gpg.format git config if given.
+// Returns an error if the command fails.
+func (api CLI) CreateSignedTag(ctx context.Context, tag string, ref string, signingKey string, signingFormat string) (err error) {
+	var args []string
+
+	if signingFormat != "" {
+		args = append(args, "-c", fmt.Sprintf("gpg.format=%s", signingFormat))
+	}
+
+	args = append(args, "tag")
+
+	if signingKey != "" {
+		args = append(args, "-u", signingKey)
+	} else {
+		args = append(args, "-s")
+	}
+
+	args = append(args, tag, "-m", tag, ref)
+
+	return api.run(ctx, args...)
+}
+
+// DeleteRemoteTag deletes a git tag on a remote.
+// Returns an error if the command failed.
+func (api CLI) DeleteRemoteTag(ctx context.Context, remote string, tag string) (err error) {
+	return api.run(ctx, "push", remote, "--delete", "refs/tags/"+tag)
+}
+
+// DeleteTag deletes a local git tag.
+// Returns an error if the command fails.
+func (api CLI) DeleteTag(ctx context.Context, tag string) (err error) {
+	return api.run(ctx, "tag", "-d", tag)
+}
+
+// FetchTags fetches all tags from a remote.
 // Returns the output and an error if the command fails.
-func (api CLI) FetchTags() (output string, err error) {
-	return api.Commander.Output("git", "fetch", "--tags", "--verbose")
+func (api CLI) FetchTags(ctx context.Context, remote string) (output string, err error) {
+	return api.output(ctx, "fetch", remote, "--tags", "--verbose")
 }
 
 // FetchUnshallow convert a shallow repository to a complete one.
 // Returns an error if the command fails.
-func (api CLI) FetchUnshallow() (output string, err error) {
-	return api.Commander.Output("git", "fetch", "--unshallow")
+func (api CLI) FetchUnshallow(ctx context.Context) (output string, err error) {
+	return api.output(ctx, "fetch", "--unshallow")
+}
+
+// GetBranchName gets the short name of a ref, e.g. the current branch name for HEAD.
+// Returns the short name, HEAD if HEAD is detached, an empty string if the ref is not a name
+// or an error if something went wrong with git.
+func (api CLI) GetBranchName(ctx context.Context, ref string) (name string, err error) {
+	return api.output(ctx, "rev-parse", "--abbrev-ref", ref)
+}
+
+// GetCommitHash gets the hash of the commit a ref points to, e.g. HEAD or a tag.
+// Returns the commit hash or an error if the ref does not point to a commit.
+func (api CLI) GetCommitHash(ctx context.Context, ref string) (hash string, err error) {
+	return api.output(ctx, "rev-parse", "--verify", "--quiet", fmt.Sprintf("%s^{commit}", ref))
 }
 
 // GetConfig gets the git config for a specific key.
 // Returns the value of the git config as a string and an error if the command failed.
-func (api CLI) GetConfig(key string) (value string, err error) {
-	return api.Commander.Output("git", "config", "--get", key)
+func (api CLI) GetConfig(ctx context.Context, key string) (value string, err error) {
+	return api.output(ctx, "config", "--get", key)
 }
 
 // GetLatestAnnotatedTag gets the latest annotated git tag.
 // Returns the git tag and an error if the command failed.
-func (api CLI) GetLatestAnnotatedTag() (tag string, err error) {
-	return api.Commander.Output("git", "describe", "--tags")
+func (api CLI) GetLatestAnnotatedTag(ctx context.Context) (tag string, err error) {
+	return api.output(ctx, "describe", "--tags")
 }
 
-// GetLatestCommitMessage gets the latest git commit message.
+// GetLatestCommitMessage gets the git commit message of a ref, e.g. HEAD or a commit hash.
+// The ref is peeled to a commit, so annotated tags result in the message of the tagged commit.
 // Returns the git commit message or an error if the command failed.
-func (api CLI) GetLatestCommitMessage() (message string, err error) {
-	return api.Commander.Output("git", "--no-pager", "show", "-s", "--format=%s")
+func (api CLI) GetLatestCommitMessage(ctx context.Context, ref string) (message string, err error) {
+	return api.output(ctx, "--no-pager", "show", "-s", "--format=%s", fmt.Sprintf("%s^{commit}", ref))
 }
 
-// GetMergedBranchName gets the source branch name if the last commit is a merge.
-// Returns the branch name or an error if something went wrong with git.
-func (api CLI) GetMergedBranchName() (name string, err error) {
-	return api.Commander.Output(
-		"git",
+// GetMergedBranchName gets the source branch name if the commit of a ref is a merge.
+// The second parent is verified first, since git name-rev does not fail for a missing commit.
+// Returns the branch name or an error if the commit is not a merge or if something went wrong with git.
+func (api CLI) GetMergedBranchName(ctx context.Context, ref string) (name string, err error) {
+	if _, err = api.output(ctx, "rev-parse", "--verify", "--quiet", fmt.Sprintf("%s^2", ref)); err != nil {
+		return name, newNotMergeCommitError(ref)
+	}
+
+	return api.output(ctx,
 		"name-rev",
 		"--name-only",
 		"--refs=refs/heads/*",
 		"--refs=refs/remotes/*",
-		"HEAD^2",
+		fmt.Sprintf("%s^2", ref),
 	)
 }
 
+// GetMergedTags gets all tags merged into a ref, e.g. HEAD or a commit hash, both lightweight and annotated.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api CLI) GetMergedTags(ctx context.Context, ref string) (tags string, err error) {
+	return api.output(ctx, "tag", "--merged", ref, "--sort=-version:refname")
+}
+
+// GetTagDetails gets all tags with the commit they point to, their date and the subject of their annotation.
+// The date of a lightweight tag is the date of its commit, the annotation of a lightweight tag is empty.
+// Returns a string of newline separated tags formatted as "tag commit date annotation", sorted by version in descending order.
+func (api CLI) GetTagDetails(ctx context.Context) (details string, err error) {
+	return api.output(ctx, "for-each-ref", "--sort=-version:refname", "--format="+tagDetailsFormat, "refs/tags")
+}
+
 // GetTags gets all tags, both lightweight and annotated.
 // Returns a string of newline separated tags, sorted by version in descending order.
-func (api CLI) GetTags() (tags string, err error) {
-	return api.Commander.Output("git", "tag", "--sort=-version:refname")
+func (api CLI) GetTags(ctx context.Context) (tags string, err error) {
+	return api.output(ctx, "tag", "--sort=-version:refname")
 }
 
-// PushTag pushes a tag to the remote origin.
-// Returns an error if the command failed.
-func (api CLI) PushTag(tag string) (err error) {
-	return api.Commander.Run("git", "push", "origin", tag)
+// PushTag pushes a tag to a remote.
+// Returns a RejectedError if the remote rejected the tag, e.g. because it already exists,
+// or another error if the command failed.
+func (api CLI) PushTag(ctx context.Context, remote string, tag string) (err error) {
+	if err = api.run(ctx, "push", remote, tag); err != nil {
+		var commandError cmder.CommandError
+
+		if errors.As(err, &commandError) && strings.Contains(commandError.Output, "[rejected]") {
+			return NewRejectedError(remote, tag, err)
+		}
+	}
+
+	return err
 }
 
 // SetConfig sets a git config key and value.
 // Returns an error if the command failed.
-func (api CLI) SetConfig(key string, value string) (err error) {
-	return api.Commander.Run("git", "config", key, value)
+func (api CLI) SetConfig(ctx context.Context, key string, value string) (err error) {
+	return api.run(ctx, "config", key, value)
 }
 
 // SetConfigIfNotSet sets a git config key and value if the config does not exist.
 // Returns the actual value and an error if the command failed.
-func (api CLI) SetConfigIfNotSet(key string, value string) (actual string, err error) {
-	if actual, err = api.GetConfig(key); err != nil {
-		err = api.SetConfig(key, value)
+func (api CLI) SetConfigIfNotSet(ctx context.Context, key string, value string) (actual string, err error) {
+	if actual, err = api.GetConfig(ctx, key); err != nil {
+		err = api.SetConfig(ctx, key, value)
 		actual = value
 	}
 
 	return actual, err
 }
+
+// VerifyTag verifies the signature of a git tag.
+// Returns the verification output and an error if the signature is invalid or missing.
+func (api CLI) VerifyTag(ctx context.Context, tag string) (output string, err error) {
+	return api.output(ctx, "tag", "-v", tag)
+}
+
+// output runs git in the directory of the CLI.
+// Returns the output or an error if the command failed.
+func (api CLI) output(ctx context.Context, args ...string) (output string, err error) {
+	return api.Commander.Output(ctx, "git", api.withDir(args)...)
+}
+
+// run runs git in the directory of the CLI.
+// Returns an error if the command failed.
+func (api CLI) run(ctx context.Context, args ...string) (err error) {
+	return api.Commander.Run(ctx, "git", api.withDir(args)...)
+}
+
+// withDir prepends the -C option to git arguments if a directory is configured.
+func (api CLI) withDir(args []string) []string {
+	if api.Dir == "" {
+		return args
+	}
+
+	return append([]string{"-C", api.Dir}, args...)
+}
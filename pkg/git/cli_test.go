@@ -1,12 +1,15 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	cmder "github.com/restechnica/go-cmder/pkg"
+
 	"github.com/restechnica/semverbot/internal/mocks"
 )
 
@@ -18,7 +21,88 @@ func TestCLI_CreateAnnotatedTag(t *testing.T) {
 		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var got = gitCLI.CreateAnnotatedTag("0.0.0")
+		var got = gitCLI.CreateAnnotatedTag(context.Background(), "0.0.0", HEAD, "0.0.0")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_CreateSignedTag(t *testing.T) {
+	type Test struct {
+		Name          string
+		SigningFormat string
+		SigningKey    string
+		Want          []string
+	}
+
+	var tests = []Test{
+		{Name: "SignWithDefaultKey", Want: []string{"tag", "-s", "v0.0.0", "-m", "v0.0.0", "HEAD"}},
+		{Name: "SignWithKey", SigningKey: "ABCDEF", Want: []string{"tag", "-u", "ABCDEF", "v0.0.0", "-m", "v0.0.0", "HEAD"}},
+		{Name: "SignWithFormat", SigningFormat: "ssh", SigningKey: "key.pub", Want: []string{"-c", "gpg.format=ssh", "tag", "-u", "key.pub", "v0.0.0", "-m", "v0.0.0", "HEAD"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var cmder = mocks.NewMockCommander()
+			cmder.On("Run", "git", test.Want).Return(nil)
+
+			var gitCLI = CLI{Commander: cmder}
+			var err = gitCLI.CreateSignedTag(context.Background(), "v0.0.0", HEAD, test.SigningKey, test.SigningFormat)
+
+			assert.NoError(t, err)
+			cmder.AssertExpectations(t)
+		})
+	}
+
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got = gitCLI.CreateSignedTag(context.Background(), "0.0.0", HEAD, "", "")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_DeleteRemoteTag(t *testing.T) {
+	t.Run("DeleteTagOnRemote", func(t *testing.T) {
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", "git", []string{"push", "origin", "--delete", "refs/tags/v1.0.0"}).Return(nil)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got = gitCLI.DeleteRemoteTag(context.Background(), "origin", "v1.0.0")
+
+		assert.NoError(t, got)
+	})
+
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got = gitCLI.DeleteRemoteTag(context.Background(), "origin", "tag")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_DeleteTag(t *testing.T) {
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got = gitCLI.DeleteTag(context.Background(), "tag")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -33,7 +117,7 @@ func TestCLI_FetchTags(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.FetchTags()
+		var _, got = gitCLI.FetchTags(context.Background(), "origin")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -48,7 +132,35 @@ func TestCLI_FetchUnshallow(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.FetchUnshallow()
+		var _, got = gitCLI.FetchUnshallow(context.Background())
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_GetCommitHash(t *testing.T) {
+	t.Run("PeelRefToCommit", func(t *testing.T) {
+		var want = "some-hash\n"
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", "git", []string{"rev-parse", "--verify", "--quiet", "v1.0.0^{commit}"}).Return(want, nil)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got, err = gitCLI.GetCommitHash(context.Background(), "v1.0.0")
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", mock.Anything, mock.Anything).Return("", want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var _, got = gitCLI.GetCommitHash(context.Background(), HEAD)
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -63,7 +175,7 @@ func TestCLI_GetConfig(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.GetConfig("key")
+		var _, got = gitCLI.GetConfig(context.Background(), "key")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -78,7 +190,7 @@ func TestCLI_GetLatestAnnotatedTag(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.GetLatestAnnotatedTag()
+		var _, got = gitCLI.GetLatestAnnotatedTag(context.Background())
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -93,7 +205,7 @@ func TestCLI_GetLatestCommitMessage(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.GetLatestCommitMessage()
+		var _, got = gitCLI.GetLatestCommitMessage(context.Background(), HEAD)
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -105,10 +217,79 @@ func TestCLI_GetMergedBranchName(t *testing.T) {
 		var want = fmt.Errorf("some-error")
 
 		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", "git", []string{"rev-parse", "--verify", "--quiet", "HEAD^2"}).Return("some-hash\n", nil)
 		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.GetMergedBranchName()
+		var _, got = gitCLI.GetMergedBranchName(context.Background(), HEAD)
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorIfNoMergeCommit", func(t *testing.T) {
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", "git", []string{"rev-parse", "--verify", "--quiet", "HEAD^2"}).Return("", fmt.Errorf("exit status 1"))
+
+		var gitCLI = CLI{Commander: cmder}
+		var got, err = gitCLI.GetMergedBranchName(context.Background(), HEAD)
+
+		assert.Error(t, err)
+		assert.Equal(t, "", got)
+		cmder.AssertNotCalled(t, "Output", "git", []string{"name-rev", "--name-only", "--refs=refs/heads/*", "--refs=refs/remotes/*", "HEAD^2"})
+	})
+}
+
+func TestCLI_GetMergedTags(t *testing.T) {
+	t.Run("ListTagsMergedIntoRef", func(t *testing.T) {
+		var want = "v1.0.0\n"
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", "git", []string{"tag", "--merged", "some-ref", "--sort=-version:refname"}).Return(want, nil)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got, err = gitCLI.GetMergedTags(context.Background(), "some-ref")
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var _, got = gitCLI.GetMergedTags(context.Background(), HEAD)
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_GetTagDetails(t *testing.T) {
+	t.Run("ListTagsWithDetails", func(t *testing.T) {
+		var want = "v1.0.0 some-hash 2024-01-01T00:00:00+00:00 some annotation\n"
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", "git", []string{"for-each-ref", "--sort=-version:refname", "--format=" + tagDetailsFormat, "refs/tags"}).Return(want, nil)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got, err = gitCLI.GetTagDetails(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var _, got = gitCLI.GetTagDetails(context.Background())
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -123,7 +304,7 @@ func TestCLI_GetTags(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return("value", want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.GetTags()
+		var _, got = gitCLI.GetTags(context.Background())
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -138,11 +319,50 @@ func TestCLI_PushTag(t *testing.T) {
 		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var got = gitCLI.PushTag("tag")
+		var got = gitCLI.PushTag(context.Background(), "origin", "tag")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
 	})
+
+	t.Run("ReturnRejectedErrorIfTagExistsOnRemote", func(t *testing.T) {
+		var output = " ! [rejected]        v1.0.0 -> v1.0.0 (already exists)\n"
+		var want = cmder.NewCommandError([]string{"git", "push", "origin", "v1.0.0"}, output, fmt.Errorf("exit status 1"))
+
+		var commander = mocks.NewMockCommander()
+		commander.On("Run", mock.Anything, mock.Anything).Return(want)
+
+		var gitCLI = CLI{Commander: commander}
+		var got = gitCLI.PushTag(context.Background(), "origin", "v1.0.0")
+
+		var rejected RejectedError
+
+		assert.ErrorAs(t, got, &rejected)
+		assert.Equal(t, "origin", rejected.Remote, `want: "%s, got: "%s"`, "origin", rejected.Remote)
+		assert.Equal(t, "v1.0.0", rejected.Tag, `want: "%s, got: "%s"`, "v1.0.0", rejected.Tag)
+	})
+
+	t.Run("PushInDir", func(t *testing.T) {
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", "git", []string{"-C", "some-dir", "push", "origin", "tag"}).Return(nil)
+
+		var gitCLI = CLI{Commander: cmder, Dir: "some-dir"}
+		var got = gitCLI.PushTag(context.Background(), "origin", "tag")
+
+		assert.NoError(t, got)
+		cmder.AssertExpectations(t)
+	})
+
+	t.Run("PushToRemote", func(t *testing.T) {
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Run", "git", []string{"push", "upstream", "tag"}).Return(nil)
+
+		var gitCLI = CLI{Commander: cmder}
+		var got = gitCLI.PushTag(context.Background(), "upstream", "tag")
+
+		assert.NoError(t, got)
+		cmder.AssertExpectations(t)
+	})
 }
 
 func TestCLI_SetConfig(t *testing.T) {
@@ -153,7 +373,7 @@ func TestCLI_SetConfig(t *testing.T) {
 		cmder.On("Run", mock.Anything, mock.Anything).Return(want)
 
 		var gitCLI = CLI{Commander: cmder}
-		var got = gitCLI.SetConfig("key", "value")
+		var got = gitCLI.SetConfig(context.Background(), "key", "value")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -168,7 +388,7 @@ func TestCLI_SetConfigIfNotSet(t *testing.T) {
 		cmder.On("Output", mock.Anything, mock.Anything).Return(want, nil)
 
 		var gitCLI = CLI{Commander: cmder}
-		var got, err = gitCLI.SetConfigIfNotSet("key", "value")
+		var got, err = gitCLI.SetConfigIfNotSet(context.Background(), "key", "value")
 
 		cmder.AssertCalled(t, "Output", mock.Anything, mock.Anything)
 		cmder.AssertNotCalled(t, "Run", mock.Anything, mock.Anything)
@@ -187,7 +407,7 @@ func TestCLI_SetConfigIfNotSet(t *testing.T) {
 		cmder.On("Run", mock.Anything, mock.Anything).Return(nil)
 
 		var gitCLI = CLI{Commander: cmder}
-		var got, err = gitCLI.SetConfigIfNotSet("key", want)
+		var got, err = gitCLI.SetConfigIfNotSet(context.Background(), "key", want)
 
 		cmder.AssertCalled(t, "Output", mock.Anything, mock.Anything)
 		cmder.AssertCalled(t, "Run", mock.Anything, mock.Anything)
@@ -207,7 +427,22 @@ func TestCLI_SetConfigIfNotSet(t *testing.T) {
 		cmder.On("Run", mock.Anything, mock.Anything).Return(fmt.Errorf("some-error"))
 
 		var gitCLI = CLI{Commander: cmder}
-		var _, got = gitCLI.SetConfigIfNotSet("key", "value")
+		var _, got = gitCLI.SetConfigIfNotSet(context.Background(), "key", "value")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestCLI_VerifyTag(t *testing.T) {
+	t.Run("ReturnErrorOnCommanderError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var cmder = mocks.NewMockCommander()
+		cmder.On("Output", mock.Anything, mock.Anything).Return("", want)
+
+		var gitCLI = CLI{Commander: cmder}
+		var _, got = gitCLI.VerifyTag(context.Background(), "tag")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
@@ -216,8 +451,9 @@ func TestCLI_SetConfigIfNotSet(t *testing.T) {
 
 func TestNewCLI(t *testing.T) {
 	t.Run("ValidateState", func(t *testing.T) {
-		var cli = NewCLI()
+		var cli = NewCLI("some-dir")
 		assert.NotNil(t, cli)
 		assert.NotNil(t, cli.Commander)
+		assert.Equal(t, "some-dir", cli.Dir)
 	})
 }
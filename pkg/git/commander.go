@@ -0,0 +1,60 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	cmder "github.com/restechnica/go-cmder/pkg"
+)
+
+// waitDelay how long to wait for the output of a killed command to close,
+// e.g. when a child process like ssh keeps it open after git was killed.
+const waitDelay = time.Second
+
+// Commander interface to run commands which are stopped when a context is cancelled.
+type Commander interface {
+	Output(ctx context.Context, name string, arg ...string) (output string, err error)
+	Run(ctx context.Context, name string, arg ...string) (err error)
+}
+
+// ExecCommander implementation of the Commander interface.
+// It makes use of exec.CommandContext to run commands, which kills commands when the context is cancelled.
+type ExecCommander struct{}
+
+// NewExecCommander creates a new ExecCommander.
+// Returns the new ExecCommander.
+func NewExecCommander() ExecCommander {
+	return ExecCommander{}
+}
+
+// Output runs a command.
+// Returns the combined stdout and stderr output of the command, a cmder.CommandError if it failed
+// or the context error if the command was stopped because the context was cancelled.
+func (c ExecCommander) Output(ctx context.Context, name string, arg ...string) (output string, err error) {
+	var command = exec.CommandContext(ctx, name, arg...)
+	var buffer bytes.Buffer
+
+	command.Stdout = &buffer
+	command.Stderr = &buffer
+	command.WaitDelay = waitDelay
+
+	if err = command.Run(); err != nil {
+		if ctx.Err() != nil {
+			return output, fmt.Errorf("command %s was stopped: %w", command.Args, ctx.Err())
+		}
+
+		return output, cmder.NewCommandError(command.Args, buffer.String(), err)
+	}
+
+	return buffer.String(), nil
+}
+
+// Run runs a command.
+// Returns an error if it failed or if it was stopped.
+func (c ExecCommander) Run(ctx context.Context, name string, arg ...string) (err error) {
+	_, err = c.Output(ctx, name, arg...)
+	return err
+}
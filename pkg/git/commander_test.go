@@ -0,0 +1,61 @@
+package git
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cmder "github.com/restechnica/go-cmder/pkg"
+)
+
+func TestExecCommander_Output(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	t.Run("ReturnOutput", func(t *testing.T) {
+		var commander = NewExecCommander()
+		var got, err = commander.Output(context.Background(), "git", "--version")
+
+		assert.NoError(t, err)
+		assert.Contains(t, got, "git version")
+	})
+
+	t.Run("ReturnCommandErrorOnFailure", func(t *testing.T) {
+		var commander = NewExecCommander()
+		var _, err = commander.Output(context.Background(), "git", "some-invalid-command")
+
+		assert.ErrorAs(t, err, &cmder.CommandError{})
+	})
+
+	t.Run("ReturnContextErrorIfCancelled", func(t *testing.T) {
+		var ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		var commander = NewExecCommander()
+		var _, err = commander.Output(ctx, "git", "--version")
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestExecCommander_Run(t *testing.T) {
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep is not installed")
+	}
+
+	t.Run("KillCommandOnTimeout", func(t *testing.T) {
+		var ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		var commander = NewExecCommander()
+		var start = time.Now()
+		var err = commander.Run(ctx, "sleep", "10")
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
@@ -0,0 +1,285 @@
+package git
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// splitConfigKey splits a git config key like remote.origin.url into its section, subsection and name.
+// Sections and names are case-insensitive, subsections are case-sensitive.
+// Returns an error if the key does not contain a section and a name.
+func splitConfigKey(key string) (section string, subsection string, name string, err error) {
+	var first = strings.Index(key, ".")
+	var last = strings.LastIndex(key, ".")
+
+	if first <= 0 || last == len(key)-1 {
+		return section, subsection, name, fmt.Errorf("key does not contain a section: %s", key)
+	}
+
+	section = strings.ToLower(key[:first])
+	name = strings.ToLower(key[last+1:])
+
+	if first != last {
+		subsection = key[first+1 : last]
+	}
+
+	return section, subsection, name, nil
+}
+
+// joinConfigKey joins a section, subsection and name into a git config key.
+func joinConfigKey(section string, subsection string, name string) string {
+	if subsection == "" {
+		return fmt.Sprintf("%s.%s", section, name)
+	}
+
+	return fmt.Sprintf("%s.%s.%s", section, subsection, name)
+}
+
+// parseConfigSection parses a git config section header like [remote "origin"] or [user].
+// Returns the section and subsection, and false if the line is not a section header.
+func parseConfigSection(line string) (section string, subsection string, ok bool) {
+	if !strings.HasPrefix(line, "[") {
+		return section, subsection, false
+	}
+
+	var end = strings.LastIndex(line, "]")
+
+	if end < 0 {
+		return section, subsection, false
+	}
+
+	var header = strings.TrimSpace(line[1:end])
+
+	if name, quoted, found := strings.Cut(header, " "); found {
+		quoted = strings.TrimSpace(quoted)
+		quoted = strings.TrimPrefix(quoted, `"`)
+		quoted = strings.TrimSuffix(quoted, `"`)
+		quoted = strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(quoted)
+
+		return strings.ToLower(name), quoted, true
+	}
+
+	// deprecated [section.subsection] syntax
+	if name, sub, found := strings.Cut(header, "."); found {
+		return strings.ToLower(name), strings.ToLower(sub), true
+	}
+
+	return strings.ToLower(header), "", true
+}
+
+// parseConfigValue parses the value part of a git config line, handling quotes, escapes and comments.
+func parseConfigValue(raw string) string {
+	var builder strings.Builder
+	var quoted = false
+
+	raw = strings.TrimSpace(raw)
+
+	for i := 0; i < len(raw); i++ {
+		var c = raw[i]
+
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == '\\' && i+1 < len(raw):
+			i++
+
+			switch raw[i] {
+			case 'n':
+				builder.WriteByte('\n')
+			case 't':
+				builder.WriteByte('\t')
+			default:
+				builder.WriteByte(raw[i])
+			}
+		case (c == '#' || c == ';') && !quoted:
+			return strings.TrimSpace(builder.String())
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+// readConfigFile reads a git config file into a map of normalized keys to values.
+// Later values override earlier values, missing files result in an empty map.
+// Returns the config or an error if the file could not be read.
+func readConfigFile(path string) (config map[string]string, err error) {
+	var file *os.File
+
+	config = map[string]string{}
+
+	if file, err = os.Open(path); err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+
+		return config, err
+	}
+
+	defer file.Close()
+
+	var section, subsection string
+	var scanner = bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var line = strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if s, sub, ok := parseConfigSection(line); ok {
+			section, subsection = s, sub
+			continue
+		}
+
+		var name, value, hasValue = strings.Cut(line, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if !hasValue {
+			// a key without a value is a boolean true
+			config[joinConfigKey(section, subsection, name)] = "true"
+			continue
+		}
+
+		config[joinConfigKey(section, subsection, name)] = parseConfigValue(value)
+	}
+
+	return config, scanner.Err()
+}
+
+// globalConfigPaths returns the paths of the user-level git config files, in order of increasing precedence.
+func globalConfigPaths() (paths []string) {
+	var home, _ = os.UserHomeDir()
+	var xdg = os.Getenv("XDG_CONFIG_HOME")
+
+	if xdg == "" && home != "" {
+		xdg = filepath.Join(home, ".config")
+	}
+
+	if xdg != "" {
+		paths = append(paths, filepath.Join(xdg, "git", "config"))
+	}
+
+	if home != "" {
+		paths = append(paths, filepath.Join(home, ".gitconfig"))
+	}
+
+	return paths
+}
+
+// getConfig gets a git config value, looking at the global config files and the repository config.
+// Returns the value or an error if the key is not set.
+func (repo *repository) getConfig(key string) (value string, err error) {
+	var section, subsection, name string
+
+	if section, subsection, name, err = splitConfigKey(key); err != nil {
+		return value, err
+	}
+
+	var normalized = joinConfigKey(section, subsection, name)
+	var found = false
+	var paths = append(globalConfigPaths(), filepath.Join(repo.commonDir, "config"))
+
+	for _, path := range paths {
+		var config map[string]string
+
+		if config, err = readConfigFile(path); err != nil {
+			return value, err
+		}
+
+		if v, exists := config[normalized]; exists {
+			value = v
+			found = true
+		}
+	}
+
+	if !found {
+		return value, fmt.Errorf("config '%s' is not set", key)
+	}
+
+	return value, nil
+}
+
+// setConfig sets a git config value in the repository config.
+// An existing value is replaced, otherwise the value is added to its section, which is created if necessary.
+// Returns an error if the config file could not be written.
+func (repo *repository) setConfig(key string, value string) (err error) {
+	var section, subsection, name string
+
+	if section, subsection, name, err = splitConfigKey(key); err != nil {
+		return err
+	}
+
+	var path = filepath.Join(repo.commonDir, "config")
+	var content []byte
+
+	if content, err = os.ReadFile(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var lines = strings.Split(strings.TrimRight(string(content), "\n"), "\n")
+	var entry = fmt.Sprintf("\t%s = %s", name, quoteConfigValue(value))
+	var inSection = false
+	var sectionEnd = -1
+
+	if len(content) == 0 {
+		lines = []string{}
+	}
+
+	for i, line := range lines {
+		var trimmed = strings.TrimSpace(line)
+
+		if s, sub, ok := parseConfigSection(trimmed); ok {
+			inSection = s == section && sub == subsection
+
+			if inSection {
+				sectionEnd = i
+			}
+
+			continue
+		}
+
+		if !inSection {
+			continue
+		}
+
+		sectionEnd = i
+
+		var lineName, _, _ = strings.Cut(trimmed, "=")
+
+		if strings.ToLower(strings.TrimSpace(lineName)) == name {
+			lines[i] = entry
+			return writeFileAtomic(path, []byte(strings.Join(lines, "\n")+"\n"))
+		}
+	}
+
+	if sectionEnd >= 0 {
+		lines = append(lines[:sectionEnd+1], append([]string{entry}, lines[sectionEnd+1:]...)...)
+	} else {
+		var header = fmt.Sprintf("[%s]", section)
+
+		if subsection != "" {
+			header = fmt.Sprintf(`[%s "%s"]`, section, strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(subsection))
+		}
+
+		lines = append(lines, header, entry)
+	}
+
+	return writeFileAtomic(path, []byte(strings.Join(lines, "\n")+"\n"))
+}
+
+// quoteConfigValue quotes a git config value if it contains characters with a special meaning.
+func quoteConfigValue(value string) string {
+	var escaped = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(value)
+
+	if escaped != value || strings.ContainsAny(value, "#;") || strings.TrimSpace(value) != value {
+		return fmt.Sprintf(`"%s"`, escaped)
+	}
+
+	return value
+}
@@ -0,0 +1,163 @@
+package git
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// DryRun a git.API decorator which only performs read operations.
+// Write operations are logged with their equivalent git command instead of being performed.
+type DryRun struct {
+	API API
+}
+
+// NewDryRun creates a new DryRun which decorates an API.
+// Returns the new DryRun.
+func NewDryRun(api API) DryRun {
+	return DryRun{API: api}
+}
+
+// CreateAnnotatedTag logs the creation of an annotated git tag with a message on a ref.
+// Returns no error.
+func (api DryRun) CreateAnnotatedTag(ctx context.Context, tag string, ref string, message string) (err error) {
+	api.log("git tag -a %s -m %q %s", tag, message, ref)
+	return err
+}
+
+// CreateSignedTag logs the creation of a signed annotated git tag on a ref.
+// Returns no error.
+func (api DryRun) CreateSignedTag(ctx context.Context, tag string, ref string, signingKey string, signingFormat string) (err error) {
+	var command = "git"
+
+	if signingFormat != "" {
+		command = fmt.Sprintf("%s -c gpg.format=%s", command, signingFormat)
+	}
+
+	if signingKey != "" {
+		api.log("%s tag -u %s %s -m %s %s", command, signingKey, tag, tag, ref)
+	} else {
+		api.log("%s tag -s %s -m %s %s", command, tag, tag, ref)
+	}
+
+	return err
+}
+
+// DeleteRemoteTag logs the deletion of a git tag on a remote.
+// Returns no error.
+func (api DryRun) DeleteRemoteTag(ctx context.Context, remote string, tag string) (err error) {
+	api.log("git push %s --delete refs/tags/%s", remote, tag)
+	return err
+}
+
+// DeleteTag logs the deletion of a local git tag.
+// Returns no error.
+func (api DryRun) DeleteTag(ctx context.Context, tag string) (err error) {
+	api.log("git tag -d %s", tag)
+	return err
+}
+
+// FetchTags logs fetching all tags from a remote.
+// Returns no output and no error.
+func (api DryRun) FetchTags(ctx context.Context, remote string) (output string, err error) {
+	api.log("git fetch %s --tags --verbose", remote)
+	return output, err
+}
+
+// FetchUnshallow logs converting a shallow repository to a complete one.
+// Returns no output and no error.
+func (api DryRun) FetchUnshallow(ctx context.Context) (output string, err error) {
+	api.log("git fetch --unshallow")
+	return output, err
+}
+
+// GetBranchName gets the short name of a ref from the decorated API.
+// Returns the short name or an error if something went wrong.
+func (api DryRun) GetBranchName(ctx context.Context, ref string) (name string, err error) {
+	return api.API.GetBranchName(ctx, ref)
+}
+
+// GetCommitHash gets the hash of the commit a ref points to from the decorated API.
+// Returns the commit hash or an error if it failed.
+func (api DryRun) GetCommitHash(ctx context.Context, ref string) (hash string, err error) {
+	return api.API.GetCommitHash(ctx, ref)
+}
+
+// GetConfig gets the git config for a specific key from the decorated API.
+// Returns the value of the git config and an error if the key is not set.
+func (api DryRun) GetConfig(ctx context.Context, key string) (value string, err error) {
+	return api.API.GetConfig(ctx, key)
+}
+
+// GetLatestAnnotatedTag gets the latest annotated git tag from the decorated API.
+// Returns the git tag and an error if it failed.
+func (api DryRun) GetLatestAnnotatedTag(ctx context.Context) (tag string, err error) {
+	return api.API.GetLatestAnnotatedTag(ctx)
+}
+
+// GetLatestCommitMessage gets the git commit message of a ref from the decorated API.
+// Returns the git commit message or an error if it failed.
+func (api DryRun) GetLatestCommitMessage(ctx context.Context, ref string) (message string, err error) {
+	return api.API.GetLatestCommitMessage(ctx, ref)
+}
+
+// GetMergedBranchName gets the source branch name if the commit of a ref is a merge from the decorated API.
+// Returns the branch name or an error if it failed.
+func (api DryRun) GetMergedBranchName(ctx context.Context, ref string) (name string, err error) {
+	return api.API.GetMergedBranchName(ctx, ref)
+}
+
+// GetMergedTags gets all tags merged into a ref from the decorated API.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api DryRun) GetMergedTags(ctx context.Context, ref string) (tags string, err error) {
+	return api.API.GetMergedTags(ctx, ref)
+}
+
+// GetTagDetails gets all tags with their details from the decorated API.
+// Returns a string of newline separated tags with their details, sorted by version in descending order.
+func (api DryRun) GetTagDetails(ctx context.Context) (details string, err error) {
+	return api.API.GetTagDetails(ctx)
+}
+
+// GetTags gets all tags from the decorated API.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api DryRun) GetTags(ctx context.Context) (tags string, err error) {
+	return api.API.GetTags(ctx)
+}
+
+// PushTag logs pushing a tag to a remote.
+// Returns no error.
+func (api DryRun) PushTag(ctx context.Context, remote string, tag string) (err error) {
+	api.log("git push %s %s", remote, tag)
+	return err
+}
+
+// SetConfig logs setting a git config key and value.
+// Returns no error.
+func (api DryRun) SetConfig(ctx context.Context, key string, value string) (err error) {
+	api.log("git config %s %s", key, value)
+	return err
+}
+
+// SetConfigIfNotSet logs setting a git config key and value if the config does not exist.
+// Returns the actual value, which is the given value if the config does not exist.
+func (api DryRun) SetConfigIfNotSet(ctx context.Context, key string, value string) (actual string, err error) {
+	if actual, err = api.GetConfig(ctx, key); err != nil {
+		err = api.SetConfig(ctx, key, value)
+		actual = value
+	}
+
+	return actual, err
+}
+
+// VerifyTag verifies the signature of a git tag with the decorated API.
+// Returns the verification output and an error if the signature is invalid or missing.
+func (api DryRun) VerifyTag(ctx context.Context, tag string) (output string, err error) {
+	return api.API.VerifyTag(ctx, tag)
+}
+
+// log logs a git command which would have been run.
+func (api DryRun) log(format string, args ...any) {
+	log.Warn().Str("command", fmt.Sprintf(format, args...)).Msg("dry run, skipping")
+}
@@ -0,0 +1,77 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/restechnica/semverbot/internal/mocks"
+)
+
+func TestDryRun_WriteOperations(t *testing.T) {
+	t.Run("DoNotCallDecoratedAPI", func(t *testing.T) {
+		// the mock has no expectations, any call to it fails the test
+		var gitAPI = mocks.NewMockGitAPI()
+		var dryRun = NewDryRun(gitAPI)
+		var ctx = context.Background()
+		var err error
+
+		assert.NoError(t, dryRun.CreateAnnotatedTag(ctx, "v1.0.0", HEAD, "v1.0.0"))
+		assert.NoError(t, dryRun.CreateSignedTag(ctx, "v1.0.0", HEAD, "key", "ssh"))
+		assert.NoError(t, dryRun.DeleteRemoteTag(ctx, "origin", "v1.0.0"))
+		assert.NoError(t, dryRun.DeleteTag(ctx, "v1.0.0"))
+		assert.NoError(t, dryRun.PushTag(ctx, "origin", "v1.0.0"))
+		assert.NoError(t, dryRun.SetConfig(ctx, "user.name", "semverbot"))
+
+		_, err = dryRun.FetchTags(ctx, "origin")
+		assert.NoError(t, err)
+
+		_, err = dryRun.FetchUnshallow(ctx)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDryRun_ReadOperations(t *testing.T) {
+	t.Run("CallDecoratedAPI", func(t *testing.T) {
+		var want = "v1.0.0\n"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return(want, nil)
+
+		var dryRun = NewDryRun(gitAPI)
+		var got, err = dryRun.GetTags(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestDryRun_SetConfigIfNotSet(t *testing.T) {
+	type Test struct {
+		ConfigError error
+		ConfigValue string
+		Name        string
+		Want        string
+	}
+
+	var tests = []Test{
+		{Name: "ReturnExistingValue", ConfigValue: "existing", Want: "existing"},
+		{Name: "ReturnValueWithoutSettingIt", ConfigError: fmt.Errorf("some-error"), Want: "semverbot"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = mocks.NewMockGitAPI()
+			gitAPI.On("GetConfig", "user.name").Return(test.ConfigValue, test.ConfigError)
+
+			var dryRun = NewDryRun(gitAPI)
+			var got, err = dryRun.SetConfigIfNotSet(context.Background(), "user.name", "semverbot")
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+			gitAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package git
+
+import "fmt"
+
+// NewRejectedError creates a new RejectedError for a tag a remote refused to accept.
+// Returns the new RejectedError.
+func NewRejectedError(remote string, tag string, err error) RejectedError {
+	return RejectedError{Err: err, Remote: remote, Tag: tag}
+}
+
+// newNotMergeCommitError creates a new error for a ref whose commit is not a merge commit, so it has no merged branch.
+// Returns the new error.
+func newNotMergeCommitError(ref string) error {
+	return fmt.Errorf("the commit of '%s' is not a merge commit", ref)
+}
+
+// RejectedError an error returned when a remote rejects a pushed tag, e.g. because the tag already exists on the remote.
+type RejectedError struct {
+	Err    error
+	Remote string
+	Tag    string
+}
+
+func (e RejectedError) Error() string {
+	return fmt.Sprintf("remote '%s' rejected tag '%s': %s", e.Remote, e.Tag, e.Err)
+}
+
+func (e RejectedError) Unwrap() error {
+	return e.Err
+}
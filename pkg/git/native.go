@@ -0,0 +1,915 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// NativeBackend backend name for Native.
+const NativeBackend = "native"
+
+// describeCandidates the maximum number of tagged commits considered to describe a commit, like git describe --candidates.
+const describeCandidates = 10
+
+// Native a git.API which reads and writes the git repository directly, without a git installation.
+// Operations which require network access are not supported.
+type Native struct {
+	Dir string
+
+	// shared the repository opened by the first call, which keeps packfiles open and caches objects between calls.
+	shared *sharedRepository
+}
+
+// sharedRepository a repository opened once and shared by all copies of a Native.
+type sharedRepository struct {
+	mutex sync.Mutex
+	repo  *repository
+}
+
+// NewNative creates a new Native for the repository containing a directory.
+// The current working directory is used if the directory is empty.
+// Returns the new Native.
+func NewNative(dir string) Native {
+	return Native{Dir: dir, shared: &sharedRepository{}}
+}
+
+// CreateAnnotatedTag creates an annotated git tag with a message on a ref, e.g. HEAD or a commit hash,
+// by writing a tag object and a ref.
+// Returns an error if the tag already exists, if the ref does not exist or if the user identity is not configured.
+func (api Native) CreateAnnotatedTag(ctx context.Context, tag string, ref string, message string) (err error) {
+	var repo *repository
+	var target, kind, tagger, hash string
+
+	if repo, err = api.open(ctx); err != nil {
+		return err
+	}
+
+	var tagRef = "refs/tags/" + tag
+
+	if repo.refExists(tagRef) {
+		return fmt.Errorf("tag '%s' already exists", tag)
+	}
+
+	if target, err = repo.resolveRevision(ref); err != nil {
+		return err
+	}
+
+	if kind, _, err = repo.readObject(target); err != nil {
+		return err
+	}
+
+	if tagger, err = api.getIdentity(repo); err != nil {
+		return err
+	}
+
+	var now = time.Now()
+	var content = fmt.Sprintf(
+		"object %s\ntype %s\ntag %s\ntagger %s %d %s\n\n%s\n",
+		target,
+		kind,
+		tag,
+		tagger,
+		now.Unix(),
+		now.Format("-0700"),
+		strings.TrimRight(message, "\n"),
+	)
+
+	if hash, err = repo.writeObject(objectTag, []byte(content)); err != nil {
+		return err
+	}
+
+	return repo.writeRef(tagRef, hash)
+}
+
+// CreateSignedTag is not supported because signing requires external tools like gpg or ssh-keygen.
+// Returns an error.
+func (api Native) CreateSignedTag(ctx context.Context, tag string, ref string, signingKey string, signingFormat string) (err error) {
+	return fmt.Errorf("the %s git backend does not support signing tags", NativeBackend)
+}
+
+// DeleteRemoteTag is not supported because it requires network access.
+// Returns an error.
+func (api Native) DeleteRemoteTag(ctx context.Context, remote string, tag string) (err error) {
+	return fmt.Errorf("the %s git backend does not support deleting remote tags", NativeBackend)
+}
+
+// DeleteTag deletes a local git tag by removing its loose and packed ref.
+// Returns an error if the tag does not exist or if the refs could not be written.
+func (api Native) DeleteTag(ctx context.Context, tag string) (err error) {
+	var repo *repository
+
+	if repo, err = api.open(ctx); err != nil {
+		return err
+	}
+
+	var tagRef = "refs/tags/" + tag
+
+	if !repo.refExists(tagRef) {
+		return fmt.Errorf("tag '%s' not found", tag)
+	}
+
+	return repo.deleteRef(tagRef)
+}
+
+// FetchTags is not supported because it requires network access.
+// Returns an error.
+func (api Native) FetchTags(ctx context.Context, remote string) (output string, err error) {
+	return output, fmt.Errorf("the %s git backend does not support fetching tags", NativeBackend)
+}
+
+// FetchUnshallow is not supported because it requires network access.
+// Returns an error.
+func (api Native) FetchUnshallow(ctx context.Context) (output string, err error) {
+	return output, fmt.Errorf("the %s git backend does not support fetching an unshallow repository", NativeBackend)
+}
+
+// GetBranchName gets the short name of a ref, e.g. the current branch name for HEAD.
+// Returns the short name, HEAD if HEAD is detached, an empty string if the ref is not a name
+// or an error if the ref does not exist.
+func (api Native) GetBranchName(ctx context.Context, ref string) (name string, err error) {
+	var repo *repository
+
+	if repo, err = api.open(ctx); err != nil {
+		return name, err
+	}
+
+	if _, err = repo.resolveRevision(ref); err != nil {
+		return name, err
+	}
+
+	if name, err = repo.shortName(ref); err != nil || name == "" {
+		return name, err
+	}
+
+	return name + "\n", nil
+}
+
+// GetCommitHash gets the hash of the commit a ref points to, e.g. HEAD or a tag.
+// Returns the commit hash or an error if the ref does not point to a commit.
+func (api Native) GetCommitHash(ctx context.Context, ref string) (hash string, err error) {
+	var repo *repository
+	var kind string
+
+	if repo, err = api.open(ctx); err != nil {
+		return hash, err
+	}
+
+	if hash, err = repo.resolveRevision(ref); err != nil {
+		return hash, err
+	}
+
+	if hash, err = repo.peel(hash); err != nil {
+		return hash, err
+	}
+
+	if kind, _, err = repo.readObject(hash); err != nil {
+		return hash, err
+	}
+
+	if kind != objectCommit {
+		return "", fmt.Errorf("ref '%s' does not point to a commit", ref)
+	}
+
+	return hash + "\n", nil
+}
+
+// GetConfig gets the git config for a specific key from the global and the repository config files.
+// Returns the value of the git config and an error if the key is not set.
+func (api Native) GetConfig(ctx context.Context, key string) (value string, err error) {
+	var repo *repository
+
+	if repo, err = api.open(ctx); err != nil {
+		return value, err
+	}
+
+	if value, err = repo.getConfig(key); err != nil {
+		return value, err
+	}
+
+	return value + "\n", err
+}
+
+// GetLatestAnnotatedTag gets the latest tag reachable from HEAD, in the format of git describe --tags.
+// Like git describe, commits are walked from the most recent commit date and the first tagged commits are candidates,
+// of which the one with the fewest walked commits it can not reach is described.
+// Returns the tag, suffixed with the distance and abbreviated commit if HEAD is not tagged, or an error if no tag is reachable.
+func (api Native) GetLatestAnnotatedTag(ctx context.Context) (tag string, err error) {
+	var repo *repository
+	var head string
+	var tags map[string]string
+
+	if repo, err = api.open(ctx); err != nil {
+		return tag, err
+	}
+
+	if head, err = repo.resolveRef("HEAD"); err != nil {
+		return tag, err
+	}
+
+	if tags, err = repo.listRefs("refs/tags/"); err != nil {
+		return tag, err
+	}
+
+	var tagsByCommit = map[string][]string{}
+	var annotated = map[string]bool{}
+
+	for _, name := range sortedRefNames(tags) {
+		var peeled string
+
+		if peeled, err = repo.peel(tags[name]); err != nil {
+			return tag, err
+		}
+
+		tagsByCommit[peeled] = append(tagsByCommit[peeled], strings.TrimPrefix(name, "refs/tags/"))
+
+		// an annotated tag points to a tag object instead of the commit itself
+		if peeled != tags[name] {
+			annotated[peeled] = true
+		}
+	}
+
+	var described string
+	var depth int
+
+	if described, depth, err = repo.describe(head, tagsByCommit, annotated); err != nil {
+		return tag, err
+	}
+
+	var names = tagsByCommit[described]
+	sort.Slice(names, func(i, j int) bool { return compareVersions(names[i], names[j]) > 0 })
+
+	if described == head {
+		return names[0] + "\n", nil
+	}
+
+	return fmt.Sprintf("%s-%d-g%s\n", names[0], depth, head[:7]), nil
+}
+
+// GetLatestCommitMessage gets the subject of the git commit message of a ref, e.g. HEAD or a commit hash.
+// Returns the git commit message or an error if the ref could not be read.
+func (api Native) GetLatestCommitMessage(ctx context.Context, ref string) (message string, err error) {
+	var repo *repository
+	var target commit
+
+	if repo, err = api.open(ctx); err != nil {
+		return message, err
+	}
+
+	if target, err = repo.readRevision(ref); err != nil {
+		return message, err
+	}
+
+	return target.Subject() + "\n", nil
+}
+
+// GetMergedBranchName gets the source branch name if the commit of a ref is a merge.
+// Returns the branch name or an error if the commit is not a merge or if something went wrong.
+func (api Native) GetMergedBranchName(ctx context.Context, ref string) (name string, err error) {
+	var repo *repository
+	var target commit
+
+	if repo, err = api.open(ctx); err != nil {
+		return name, err
+	}
+
+	if target, err = repo.readRevision(ref); err != nil {
+		return name, err
+	}
+
+	if len(target.Parents) < 2 {
+		return name, newNotMergeCommitError(ref)
+	}
+
+	if name, err = repo.nameRev(target.Parents[1], []string{"refs/heads/", "refs/remotes/"}); err != nil {
+		return name, err
+	}
+
+	return name + "\n", nil
+}
+
+// GetMergedTags gets all tags merged into a ref, e.g. HEAD or a commit hash, both lightweight and annotated.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api Native) GetMergedTags(ctx context.Context, ref string) (tags string, err error) {
+	var repo *repository
+	var target string
+	var ancestors []string
+
+	if repo, err = api.open(ctx); err != nil {
+		return tags, err
+	}
+
+	if target, err = repo.resolveRevision(ref); err != nil {
+		return tags, err
+	}
+
+	if target, err = repo.peel(target); err != nil {
+		return tags, err
+	}
+
+	if ancestors, err = repo.ancestors(target); err != nil {
+		return tags, err
+	}
+
+	var merged = map[string]bool{}
+
+	for _, hash := range ancestors {
+		merged[hash] = true
+	}
+
+	return api.listTags(repo, func(hash string) bool { return merged[hash] })
+}
+
+// GetTagDetails gets all tags with the commit they point to, their date and the subject of their annotation.
+// The date of a lightweight tag is the date of its commit, the annotation of a lightweight tag is empty.
+// Returns a string of newline separated tags formatted as "tag commit date annotation", sorted by version in descending order.
+func (api Native) GetTagDetails(ctx context.Context) (details string, err error) {
+	var repo *repository
+	var refs map[string]string
+
+	if repo, err = api.open(ctx); err != nil {
+		return details, err
+	}
+
+	if refs, err = repo.listRefs("refs/tags/"); err != nil {
+		return details, err
+	}
+
+	var names = make([]string, 0, len(refs))
+
+	for ref := range refs {
+		names = append(names, strings.TrimPrefix(ref, "refs/tags/"))
+	}
+
+	sort.Slice(names, func(i, j int) bool { return compareVersions(names[i], names[j]) > 0 })
+
+	var builder strings.Builder
+
+	for _, name := range names {
+		var target, date, annotation string
+
+		if target, date, annotation, err = repo.readTagDetails(refs["refs/tags/"+name]); err != nil {
+			return details, err
+		}
+
+		fmt.Fprintf(&builder, "%s %s %s %s\n", name, target, date, annotation)
+	}
+
+	return builder.String(), nil
+}
+
+// GetTags gets all tags, both lightweight and annotated.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api Native) GetTags(ctx context.Context) (tags string, err error) {
+	var repo *repository
+
+	if repo, err = api.open(ctx); err != nil {
+		return tags, err
+	}
+
+	return api.listTags(repo, nil)
+}
+
+// listTags lists the tags whose peeled object matches a filter, or all tags if the filter is nil.
+// Returns a string of newline separated tags, sorted by version in descending order.
+func (api Native) listTags(repo *repository, filter func(hash string) bool) (tags string, err error) {
+	var refs map[string]string
+
+	if refs, err = repo.listRefs("refs/tags/"); err != nil {
+		return tags, err
+	}
+
+	var names = make([]string, 0, len(refs))
+
+	for ref, hash := range refs {
+		if filter != nil {
+			var peeled string
+
+			if peeled, err = repo.peel(hash); err != nil {
+				return tags, err
+			}
+
+			if !filter(peeled) {
+				continue
+			}
+		}
+
+		names = append(names, strings.TrimPrefix(ref, "refs/tags/"))
+	}
+
+	sort.Slice(names, func(i, j int) bool { return compareVersions(names[i], names[j]) > 0 })
+
+	if len(names) == 0 {
+		return tags, nil
+	}
+
+	return strings.Join(names, "\n") + "\n", nil
+}
+
+// PushTag is not supported because it requires network access.
+// Returns an error.
+func (api Native) PushTag(ctx context.Context, remote string, tag string) (err error) {
+	return fmt.Errorf("the %s git backend does not support pushing tags", NativeBackend)
+}
+
+// SetConfig sets a git config key and value in the repository config.
+// Returns an error if the config could not be written.
+func (api Native) SetConfig(ctx context.Context, key string, value string) (err error) {
+	var repo *repository
+
+	if repo, err = api.open(ctx); err != nil {
+		return err
+	}
+
+	return repo.setConfig(key, value)
+}
+
+// SetConfigIfNotSet sets a git config key and value if the config does not exist.
+// Returns the actual value and an error if the config could not be written.
+func (api Native) SetConfigIfNotSet(ctx context.Context, key string, value string) (actual string, err error) {
+	if actual, err = api.GetConfig(ctx, key); err != nil {
+		err = api.SetConfig(ctx, key, value)
+		actual = value
+	}
+
+	return actual, err
+}
+
+// VerifyTag is not supported because verifying requires external tools like gpg or ssh-keygen.
+// Returns an error.
+func (api Native) VerifyTag(ctx context.Context, tag string) (output string, err error) {
+	return output, fmt.Errorf("the %s git backend does not support verifying tags", NativeBackend)
+}
+
+// open opens the repository containing the directory of the Native.
+// A Native created by NewNative opens the repository once and reuses it, a Native literal opens it on every call.
+// Returns the repository or an error if the context is done or if no repository could be found.
+func (api Native) open(ctx context.Context) (repo *repository, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if api.shared == nil {
+		return openRepository(api.Dir)
+	}
+
+	api.shared.mutex.Lock()
+	defer api.shared.mutex.Unlock()
+
+	if api.shared.repo == nil {
+		if api.shared.repo, err = openRepository(api.Dir); err != nil {
+			return nil, err
+		}
+	}
+
+	return api.shared.repo, nil
+}
+
+// getIdentity gets the tagger identity from the environment or the git config, like git does.
+// Returns the identity formatted as "name <email>" or an error if it is not configured.
+func (api Native) getIdentity(repo *repository) (identity string, err error) {
+	var name = os.Getenv("GIT_COMMITTER_NAME")
+	var email = os.Getenv("GIT_COMMITTER_EMAIL")
+
+	if name == "" {
+		if name, err = repo.getConfig("user.name"); err != nil {
+			return identity, fmt.Errorf("user.name is not configured")
+		}
+	}
+
+	if email == "" {
+		if email, err = repo.getConfig("user.email"); err != nil {
+			return identity, fmt.Errorf("user.email is not configured")
+		}
+	}
+
+	return fmt.Sprintf("%s <%s>", name, email), nil
+}
+
+// readRevision reads the commit a revision like HEAD or a commit hash points to.
+func (repo *repository) readRevision(revision string) (target commit, err error) {
+	var hash string
+
+	if hash, err = repo.resolveRevision(revision); err != nil {
+		return target, err
+	}
+
+	return repo.readCommit(hash)
+}
+
+// readTagDetails reads the object a tag ref points to, like git for-each-ref does for GetTagDetails.
+// Annotated tags are dereferenced once, lightweight tags have the date of their commit and no annotation.
+// Returns the target object, the date in strict ISO 8601 format and the annotation subject or an error if the object could not be read.
+func (repo *repository) readTagDetails(hash string) (target string, date string, annotation string, err error) {
+	var kind string
+	var data []byte
+
+	if kind, data, err = repo.readObject(hash); err != nil {
+		return target, date, annotation, err
+	}
+
+	var headers, message, _ = strings.Cut(string(data), "\n\n")
+	var signature string
+
+	switch kind {
+	case objectTag:
+		for _, line := range strings.Split(headers, "\n") {
+			if value, found := strings.CutPrefix(line, "object "); found {
+				target = value
+			} else if value, found = strings.CutPrefix(line, "tagger "); found {
+				signature = value
+			}
+		}
+
+		annotation = commit{Message: message}.Subject()
+	case objectCommit:
+		target = hash
+
+		for _, line := range strings.Split(headers, "\n") {
+			if value, found := strings.CutPrefix(line, "committer "); found {
+				signature = value
+			}
+		}
+	default:
+		return hash, date, annotation, nil
+	}
+
+	return target, formatSignatureDate(signature), annotation, nil
+}
+
+// formatSignatureDate formats the date of a signature like "name <email> 1700000000 +0100" in strict ISO 8601 format.
+// Returns the formatted date or an empty string if the signature has no valid date.
+func formatSignatureDate(signature string) string {
+	var fields = strings.Fields(signature)
+
+	if len(fields) < 2 {
+		return ""
+	}
+
+	var seconds, err = strconv.ParseInt(fields[len(fields)-2], 10, 64)
+
+	if err != nil {
+		return ""
+	}
+
+	var zone time.Time
+
+	if zone, err = time.Parse("-0700", fields[len(fields)-1]); err != nil {
+		return ""
+	}
+
+	return time.Unix(seconds, 0).In(zone.Location()).Format("2006-01-02T15:04:05-07:00")
+}
+
+// ancestors lists a commit and all of its ancestors in breadth-first order.
+// Returns the commit hashes or an error if a commit could not be read.
+func (repo *repository) ancestors(hash string) (hashes []string, err error) {
+	var visited = map[string]bool{hash: true}
+	var queue = []string{hash}
+
+	for len(queue) > 0 {
+		var current = queue[0]
+		var parsed commit
+
+		queue = queue[1:]
+		hashes = append(hashes, current)
+
+		if parsed, err = repo.readCommit(current); err != nil {
+			return hashes, err
+		}
+
+		for _, parent := range parsed.Parents {
+			if !visited[parent] {
+				visited[parent] = true
+				queue = append(queue, parent)
+			}
+		}
+	}
+
+	return hashes, nil
+}
+
+// describeCandidate a tagged commit which might describe another commit, see repository.describe.
+type describeCandidate struct {
+	hash  string
+	depth int
+	flag  uint32
+}
+
+// describe finds the tagged commit to describe a commit with, following the candidate search of git describe.
+// The first tagged commits of a walk by commit date are candidates, their depth counts the walked commits they can not reach.
+// Returns the tagged commit with the lowest depth and its depth, or an error if no tagged commit is reachable.
+func (repo *repository) describe(hash string, tagged map[string][]string, annotated map[string]bool) (described string, depth int, err error) {
+	if _, found := tagged[hash]; found {
+		return hash, 0, nil
+	}
+
+	var walk = &commitWalk{repo: repo, commits: map[string]commit{}, flags: map[string]uint32{hash: walkSeen}}
+
+	if err = walk.push(hash); err != nil {
+		return described, depth, err
+	}
+
+	var candidates []*describeCandidate
+	var annotatedCount, walked = 0, 0
+	var gaveUpOn string
+
+	for len(walk.queue) > 0 {
+		var current = walk.pop()
+		walked++
+
+		if _, found := tagged[current]; found {
+			if len(candidates) == describeCandidates {
+				gaveUpOn = current
+				break
+			}
+
+			var candidate = &describeCandidate{hash: current, depth: walked - 1, flag: 1 << (len(candidates) + 1)}
+			candidates = append(candidates, candidate)
+			walk.flags[current] |= candidate.flag
+
+			if annotated[current] {
+				annotatedCount++
+			}
+		}
+
+		for _, candidate := range candidates {
+			if walk.flags[current]&candidate.flag == 0 {
+				candidate.depth++
+			}
+		}
+
+		// the walk is done once the last commit to walk is reachable from all of the best candidates
+		if annotatedCount > 0 && len(walk.queue) == 0 {
+			var bestDepth = -1
+			var bestFlags uint32
+
+			for _, candidate := range candidates {
+				if bestDepth < 0 || candidate.depth < bestDepth {
+					bestDepth, bestFlags = candidate.depth, candidate.flag
+				} else if candidate.depth == bestDepth {
+					bestFlags |= candidate.flag
+				}
+			}
+
+			if walk.flags[current]&bestFlags == bestFlags {
+				break
+			}
+		}
+
+		if err = walk.expand(current); err != nil {
+			return described, depth, err
+		}
+	}
+
+	if len(candidates) == 0 {
+		return described, depth, fmt.Errorf("no names found, cannot describe anything")
+	}
+
+	// candidates with the same depth keep the order in which they were found
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].depth < candidates[j].depth })
+
+	var best = candidates[0]
+
+	if gaveUpOn != "" {
+		if err = walk.push(gaveUpOn); err != nil {
+			return described, depth, err
+		}
+	}
+
+	// finish counting the commits the best candidate can not reach
+	for len(walk.queue) > 0 {
+		var current = walk.pop()
+
+		if walk.flags[current]&best.flag == 0 {
+			best.depth++
+		} else if walk.reachableFrom(best.flag) {
+			break
+		}
+
+		if err = walk.expand(current); err != nil {
+			return described, depth, err
+		}
+	}
+
+	return best.hash, best.depth, nil
+}
+
+// walkSeen the flag of commits which were added to a commitWalk.
+const walkSeen uint32 = 1
+
+// commitWalk walks commits from the most recent commit date, like the date sorted commit lists of git.
+// Commits with the same date are walked in the order they were added. Parents inherit the flags of their commits.
+type commitWalk struct {
+	repo    *repository
+	commits map[string]commit
+	flags   map[string]uint32
+	queue   []string
+}
+
+// push adds a commit to the walk before the first queued commit with an older commit date.
+// Returns an error if the commit could not be read.
+func (walk *commitWalk) push(hash string) (err error) {
+	var parsed, found = walk.commits[hash]
+
+	if !found {
+		if parsed, err = walk.repo.readCommit(hash); err != nil {
+			return err
+		}
+
+		walk.commits[hash] = parsed
+	}
+
+	var index = len(walk.queue)
+
+	for i, queued := range walk.queue {
+		if walk.commits[queued].Time < parsed.Time {
+			index = i
+			break
+		}
+	}
+
+	walk.queue = append(walk.queue, "")
+	copy(walk.queue[index+1:], walk.queue[index:])
+	walk.queue[index] = hash
+
+	return nil
+}
+
+// pop removes the most recent commit from the walk.
+// Returns the commit.
+func (walk *commitWalk) pop() (hash string) {
+	hash, walk.queue = walk.queue[0], walk.queue[1:]
+	return hash
+}
+
+// expand adds the parents of a commit to the walk unless they were added before, and passes the flags of the commit on.
+// Returns an error if a parent could not be read.
+func (walk *commitWalk) expand(hash string) (err error) {
+	for _, parent := range walk.commits[hash].Parents {
+		if walk.flags[parent]&walkSeen == 0 {
+			if err = walk.push(parent); err != nil {
+				return err
+			}
+		}
+
+		walk.flags[parent] |= walk.flags[hash]
+	}
+
+	return nil
+}
+
+// reachableFrom checks whether all queued commits have a flag.
+// Returns true if they do, false otherwise.
+func (walk *commitWalk) reachableFrom(flag uint32) bool {
+	for _, queued := range walk.queue {
+		if walk.flags[queued]&flag == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nameRev finds a symbolic name for a commit based on the refs with the given prefixes, like git name-rev.
+// Refs under refs/heads/ are shortened to the branch name, other refs lose their refs/ prefix.
+// Returns the name, e.g. feature/x or feature/x~2, or "undefined" if no ref can reach the commit.
+func (repo *repository) nameRev(target string, prefixes []string) (name string, err error) {
+	type candidate struct {
+		base       string
+		generation int
+		hops       int
+	}
+
+	var format = func(c candidate) string {
+		if c.generation == 0 {
+			return c.base
+		}
+
+		return fmt.Sprintf("%s~%d", c.base, c.generation)
+	}
+
+	var best *candidate
+
+	for _, prefix := range prefixes {
+		var refs map[string]string
+
+		if refs, err = repo.listRefs(prefix); err != nil {
+			return name, err
+		}
+
+		for _, ref := range sortedRefNames(refs) {
+			var tip string
+
+			if tip, err = repo.peel(refs[ref]); err != nil {
+				return name, err
+			}
+
+			var short = strings.TrimPrefix(ref, "refs/")
+
+			if prefix == "refs/heads/" {
+				short = strings.TrimPrefix(ref, prefix)
+			}
+
+			var visited = map[string]bool{tip: true}
+			var queue = []candidate{{base: short}}
+			var hashes = []string{tip}
+
+			for len(queue) > 0 {
+				var current, hash = queue[0], hashes[0]
+				queue, hashes = queue[1:], hashes[1:]
+
+				if best != nil && current.hops >= best.hops {
+					break
+				}
+
+				if hash == target {
+					var found = current
+					best = &found
+					break
+				}
+
+				var parsed commit
+
+				if parsed, err = repo.readCommit(hash); err != nil {
+					return name, err
+				}
+
+				for i, parent := range parsed.Parents {
+					if visited[parent] {
+						continue
+					}
+
+					visited[parent] = true
+
+					var next = candidate{base: current.base, generation: current.generation + 1, hops: current.hops + 1}
+
+					if i > 0 {
+						next = candidate{base: fmt.Sprintf("%s^%d", format(current), i+1), hops: current.hops + 1}
+					}
+
+					queue = append(queue, next)
+					hashes = append(hashes, parent)
+				}
+			}
+		}
+	}
+
+	if best == nil {
+		return "undefined", nil
+	}
+
+	return format(*best), nil
+}
+
+// compareVersions compares two strings like git's version sort, comparing runs of digits numerically.
+// Returns a negative number if a sorts before b, a positive number if a sorts after b and zero if they are equal.
+func compareVersions(a string, b string) int {
+	var i, j = 0, 0
+
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			var startA, startB = i, j
+
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+
+			var numberA = strings.TrimLeft(a[startA:i], "0")
+			var numberB = strings.TrimLeft(b[startB:j], "0")
+
+			if len(numberA) != len(numberB) {
+				return len(numberA) - len(numberB)
+			}
+
+			if numberA != numberB {
+				return strings.Compare(numberA, numberB)
+			}
+
+			continue
+		}
+
+		if a[i] != b[j] {
+			return int(a[i]) - int(b[j])
+		}
+
+		i++
+		j++
+	}
+
+	return (len(a) - i) - (len(b) - j)
+}
+
+// isDigit returns true if a byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,648 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFixtureRepository creates a git repository with tags, a merge commit and optionally packed objects and refs.
+// The test is skipped if git is not installed, since the fixture is created with the git CLI.
+func newFixtureRepository(t *testing.T, packed bool) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	var dir = t.TempDir()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+
+	var run = func(args ...string) {
+		var command = exec.Command("git", args...)
+		command.Dir = dir
+
+		var output, err = command.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	run("init", "--quiet")
+	run("config", "user.name", "semverbot")
+	run("config", "user.email", "semverbot@github.com")
+	run("checkout", "--quiet", "-b", "main")
+	run("commit", "--quiet", "--allow-empty", "-m", "initial")
+	run("tag", "v0.2.0")
+	run("commit", "--quiet", "--allow-empty", "-m", "[fix] some bug\n\nsome description")
+	run("tag", "-a", "v0.10.0", "-m", "v0.10.0")
+	run("checkout", "--quiet", "-b", "feature/some-feature")
+	run("commit", "--quiet", "--allow-empty", "-m", "[feature] first")
+	run("commit", "--quiet", "--allow-empty", "-m", "[feature] second")
+	run("checkout", "--quiet", "main")
+	run("commit", "--quiet", "--allow-empty", "-m", "other")
+	run("merge", "--quiet", "--no-ff", "feature/some-feature", "-m", "Merge branch 'feature/some-feature'")
+
+	if packed {
+		run("gc", "--quiet", "--aggressive")
+		run("pack-refs", "--all")
+	}
+
+	return dir
+}
+
+// chdir changes the working directory for the duration of a test, because the CLI runs git in the working directory.
+func chdir(t *testing.T, dir string) {
+	var previous, err = os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+
+	t.Cleanup(func() {
+		_ = os.Chdir(previous)
+	})
+}
+
+func TestNative_MatchesCLI(t *testing.T) {
+	type Test struct {
+		Name   string
+		Packed bool
+	}
+
+	var tests = []Test{
+		{Name: "LooseObjects", Packed: false},
+		{Name: "PackedObjects", Packed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var dir = newFixtureRepository(t, test.Packed)
+			chdir(t, dir)
+
+			var backends = map[string]API{CLIBackend: NewCLI(""), NativeBackend: Native{Dir: dir}}
+
+			for name, api := range backends {
+				var tags, err = api.GetTags(context.Background())
+				assert.NoError(t, err, name)
+				assert.Equal(t, "v0.10.0\nv0.2.0\n", tags, name)
+
+				var message string
+				message, err = api.GetLatestCommitMessage(context.Background(), HEAD)
+				assert.NoError(t, err, name)
+				assert.Equal(t, "Merge branch 'feature/some-feature'\n", message, name)
+
+				var branch string
+				branch, err = api.GetMergedBranchName(context.Background(), HEAD)
+				assert.NoError(t, err, name)
+				assert.Equal(t, "feature/some-feature\n", branch, name)
+
+				var tag string
+				tag, err = api.GetLatestAnnotatedTag(context.Background())
+				assert.NoError(t, err, name)
+				assert.True(t, strings.HasPrefix(tag, "v0.10.0-4-g"), name)
+
+				var email string
+				email, err = api.GetConfig(context.Background(), "user.email")
+				assert.NoError(t, err, name)
+				assert.Equal(t, "semverbot@github.com\n", email, name)
+
+				_, err = api.GetConfig(context.Background(), "some.missing")
+				assert.Error(t, err, name)
+			}
+		})
+	}
+}
+
+func TestNative_MatchesCLIForMergedTags(t *testing.T) {
+	type Test struct {
+		Name   string
+		Packed bool
+	}
+
+	var tests = []Test{
+		{Name: "LooseObjects", Packed: false},
+		{Name: "PackedObjects", Packed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var dir = newFixtureRepository(t, false)
+
+			var run = func(args ...string) {
+				var command = exec.Command("git", args...)
+				command.Dir = dir
+
+				var output, err = command.CombinedOutput()
+				require.NoError(t, err, string(output))
+			}
+
+			run("tag", "-a", "v0.11.0", "-m", "v0.11.0", "feature/some-feature~1")
+			run("checkout", "--quiet", "-b", "hotfix/some-hotfix", "v0.10.0")
+			run("commit", "--quiet", "--allow-empty", "-m", "[fix] hotfix")
+			run("tag", "v0.10.1")
+			run("checkout", "--quiet", "main")
+
+			if test.Packed {
+				run("gc", "--quiet", "--aggressive")
+				run("pack-refs", "--all")
+			}
+
+			chdir(t, dir)
+
+			var cli = NewCLI("")
+			var native = Native{Dir: dir}
+
+			for _, ref := range []string{"HEAD", "HEAD~1", "hotfix/some-hotfix", "feature/some-feature", "v0.10.0"} {
+				var want, wantErr = cli.GetMergedTags(context.Background(), ref)
+				var got, gotErr = native.GetMergedTags(context.Background(), ref)
+
+				assert.NoError(t, wantErr, ref)
+				assert.NoError(t, gotErr, ref)
+				assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+			}
+		})
+	}
+}
+
+func TestNative_MatchesCLIForLatestAnnotatedTag(t *testing.T) {
+	type Test struct {
+		Name     string
+		Interval int64
+	}
+
+	var tests = []Test{
+		{Name: "DescribeLikeGitOnMergeHistoryWithSameCommitDates", Interval: 0},
+		{Name: "DescribeLikeGitOnMergeHistoryWithIncreasingCommitDates", Interval: 60},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var dir = newFixtureRepository(t, false)
+			var date = time.Now().Unix()
+
+			var run = func(args ...string) {
+				var command = exec.Command("git", args...)
+				command.Dir = dir
+				command.Env = append(os.Environ(), fmt.Sprintf("GIT_COMMITTER_DATE=%d +0000", date))
+
+				var output, err = command.CombinedOutput()
+				require.NoError(t, err, string(output))
+
+				date += test.Interval
+			}
+
+			// v1.0.0 is the nearest tag by parents, git describe ranks the tags by walking commits by date instead
+			run("checkout", "--quiet", "-b", "left", "main")
+			run("commit", "--quiet", "--allow-empty", "-m", "a1")
+			run("tag", "-a", "v1.0.0", "-m", "v1.0.0")
+			run("checkout", "--quiet", "-b", "right", "main")
+
+			for i := 1; i <= 5; i++ {
+				run("commit", "--quiet", "--allow-empty", "-m", "b")
+
+				if i == 4 {
+					run("tag", "-a", "v1.1.0", "-m", "v1.1.0")
+				}
+			}
+
+			run("merge", "--quiet", "--no-ff", "left", "-m", "Merge branch 'left'")
+
+			chdir(t, dir)
+
+			var want, wantErr = NewCLI("").GetLatestAnnotatedTag(context.Background())
+			var got, gotErr = Native{Dir: dir}.GetLatestAnnotatedTag(context.Background())
+
+			assert.NoError(t, wantErr)
+			assert.NoError(t, gotErr)
+			assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+		})
+	}
+}
+
+func TestNative_MatchesCLIForBranchNames(t *testing.T) {
+	var dir = newFixtureRepository(t, true)
+	chdir(t, dir)
+
+	var cli = NewCLI("")
+	var native = Native{Dir: dir}
+
+	var hash, err = cli.Commander.Output(context.Background(), "git", "rev-parse", "HEAD~1")
+	require.NoError(t, err)
+
+	var refs = []string{"HEAD", "HEAD~1", "main", "refs/heads/main", "feature/some-feature", "v0.10.0", strings.TrimSpace(hash)}
+
+	for _, ref := range refs {
+		t.Run(ref, func(t *testing.T) {
+			var want, wantErr = cli.GetBranchName(context.Background(), ref)
+			var got, gotErr = native.GetBranchName(context.Background(), ref)
+
+			assert.NoError(t, wantErr)
+			assert.NoError(t, gotErr)
+			assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+		})
+	}
+
+	t.Run("DetachedHead", func(t *testing.T) {
+		_, err = cli.Commander.Output(context.Background(), "git", "checkout", "--quiet", "--detach")
+		require.NoError(t, err)
+
+		var want, wantErr = cli.GetBranchName(context.Background(), HEAD)
+		var got, gotErr = native.GetBranchName(context.Background(), HEAD)
+
+		assert.NoError(t, wantErr)
+		assert.NoError(t, gotErr)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorIfRefDoesNotExist", func(t *testing.T) {
+		_, err = native.GetBranchName(context.Background(), "some-missing-ref")
+		assert.Error(t, err)
+	})
+}
+
+func TestNative_MatchesCLIForTagDetails(t *testing.T) {
+	type Test struct {
+		Name   string
+		Packed bool
+	}
+
+	var tests = []Test{
+		{Name: "LooseObjects", Packed: false},
+		{Name: "PackedObjects", Packed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var dir = newFixtureRepository(t, false)
+			chdir(t, dir)
+
+			var run = func(env string, args ...string) {
+				var command = exec.Command("git", args...)
+				command.Dir = dir
+				command.Env = append(os.Environ(), env)
+
+				var output, err = command.CombinedOutput()
+				require.NoError(t, err, string(output))
+			}
+
+			run("GIT_COMMITTER_DATE=2024-01-01T12:00:00+0200", "tag", "-a", "v1.0.0", "HEAD~1", "-m", "some\nmultiline subject\n\nsome body")
+			run("GIT_COMMITTER_DATE=2024-02-01T12:00:00-0500", "tag", "-a", "retracted/v1.0.0", "v1.0.0", "-m", "some reason")
+
+			if test.Packed {
+				run("", "gc", "--quiet", "--aggressive")
+				run("", "pack-refs", "--all")
+			}
+
+			var want, wantErr = NewCLI("").GetTagDetails(context.Background())
+			var got, gotErr = Native{Dir: dir}.GetTagDetails(context.Background())
+
+			// newer git versions format UTC dates with a Z suffix
+			want = strings.ReplaceAll(want, "Z ", "+00:00 ")
+
+			assert.NoError(t, wantErr)
+			assert.NoError(t, gotErr)
+			assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+			assert.Contains(t, got, "2024-01-01T12:00:00+02:00 some multiline subject\n")
+		})
+	}
+}
+
+func TestNative_MatchesCLIForRefs(t *testing.T) {
+	var dir = newFixtureRepository(t, true)
+	chdir(t, dir)
+
+	var cli = NewCLI("")
+	var native = Native{Dir: dir}
+
+	var hash, err = cli.Commander.Output(context.Background(), "git", "rev-parse", "--short", "HEAD~1")
+	require.NoError(t, err)
+
+	var refs = []string{"HEAD", "HEAD~1", "HEAD^2", "HEAD^2~1", "main", "feature/some-feature", "v0.10.0", "v0.2.0", strings.TrimSpace(hash)}
+
+	for _, ref := range refs {
+		t.Run(ref, func(t *testing.T) {
+			var want, wantErr = cli.GetLatestCommitMessage(context.Background(), ref)
+			var got, gotErr = native.GetLatestCommitMessage(context.Background(), ref)
+
+			assert.NoError(t, wantErr)
+			assert.NoError(t, gotErr)
+			assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+
+			want, wantErr = cli.GetCommitHash(context.Background(), ref)
+			got, gotErr = native.GetCommitHash(context.Background(), ref)
+
+			assert.NoError(t, wantErr)
+			assert.NoError(t, gotErr)
+			assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+		})
+	}
+
+	t.Run("ReturnErrorIfRefDoesNotExist", func(t *testing.T) {
+		_, err = native.GetLatestCommitMessage(context.Background(), "some-missing-ref")
+		assert.Error(t, err)
+
+		_, err = native.GetCommitHash(context.Background(), "some-missing-ref")
+		assert.Error(t, err)
+	})
+}
+
+func TestNative_CreateAnnotatedTag(t *testing.T) {
+	t.Run("CreateTagReadableByGit", func(t *testing.T) {
+		var dir = newFixtureRepository(t, true)
+		chdir(t, dir)
+
+		var native = Native{Dir: dir}
+
+		assert.NoError(t, native.CreateAnnotatedTag(context.Background(), "v0.11.0", HEAD, "v0.11.0"))
+
+		var cli = NewCLI("")
+
+		var tag, err = cli.GetLatestAnnotatedTag(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "v0.11.0\n", tag)
+
+		var output string
+		output, err = cli.Commander.Output(context.Background(), "git", "cat-file", "-t", "v0.11.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "tag\n", output)
+
+		output, err = cli.Commander.Output(context.Background(), "git", "fsck", "--strict")
+		assert.NoError(t, err, output)
+	})
+
+	t.Run("CreateTagWithMessage", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		chdir(t, dir)
+
+		var native = Native{Dir: dir}
+
+		assert.NoError(t, native.CreateAnnotatedTag(context.Background(), "retracted/v0.10.0", "v0.10.0", "broken build"))
+
+		var cli = NewCLI("")
+
+		var got, err = cli.Commander.Output(context.Background(), "git", "tag", "-l", "--format=%(contents:subject)", "retracted/v0.10.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "broken build\n", got, `want: "%s, got: "%s"`, "broken build\n", got)
+
+		var output string
+		output, err = cli.Commander.Output(context.Background(), "git", "fsck", "--strict")
+		assert.NoError(t, err, output)
+	})
+
+	t.Run("CreateTagOnRef", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		chdir(t, dir)
+
+		var native = Native{Dir: dir}
+
+		assert.NoError(t, native.CreateAnnotatedTag(context.Background(), "v0.11.0", "feature/some-feature", "v0.11.0"))
+
+		var cli = NewCLI("")
+
+		var want, err = cli.Commander.Output(context.Background(), "git", "rev-parse", "feature/some-feature")
+		assert.NoError(t, err)
+
+		var got string
+		got, err = cli.Commander.Output(context.Background(), "git", "rev-parse", "v0.11.0^{commit}")
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorIfTagExists", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		var native = Native{Dir: dir}
+
+		assert.Error(t, native.CreateAnnotatedTag(context.Background(), "v0.2.0", HEAD, "v0.2.0"))
+	})
+}
+
+func TestNative_DeleteTag(t *testing.T) {
+	type Test struct {
+		Name   string
+		Packed bool
+	}
+
+	var tests = []Test{
+		{Name: "DeleteLooseTag", Packed: false},
+		{Name: "DeletePackedTag", Packed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var dir = newFixtureRepository(t, test.Packed)
+			chdir(t, dir)
+
+			var native = Native{Dir: dir}
+
+			assert.NoError(t, native.DeleteTag(context.Background(), "v0.10.0"))
+
+			var got, err = NewCLI("").GetTags(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "v0.2.0\n", got, `want: "%s, got: "%s"`, "v0.2.0\n", got)
+		})
+	}
+
+	t.Run("ReturnErrorIfTagDoesNotExist", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		var native = Native{Dir: dir}
+
+		assert.Error(t, native.DeleteTag(context.Background(), "v9.9.9"))
+	})
+}
+
+func TestNative_GetMergedBranchName(t *testing.T) {
+	t.Run("ReturnErrorIfNoMergeCommitLikeCLI", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+
+		var command = exec.Command("git", "commit", "--quiet", "--allow-empty", "-m", "no merge")
+		command.Dir = dir
+		require.NoError(t, command.Run())
+
+		var backends = map[string]API{CLIBackend: NewCLI(dir), NativeBackend: Native{Dir: dir}}
+
+		for name, api := range backends {
+			var got, err = api.GetMergedBranchName(context.Background(), HEAD)
+
+			assert.Error(t, err, name)
+			assert.Equal(t, "", got, name)
+		}
+	})
+}
+
+func TestNative_Open(t *testing.T) {
+	t.Run("ReturnErrorIfContextIsDone", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		var native = Native{Dir: dir}
+
+		var ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		var _, err = native.GetTags(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNative_NetworkOperations(t *testing.T) {
+	t.Run("ReturnErrorOnNetworkOperations", func(t *testing.T) {
+		var native = NewNative("")
+		var err error
+
+		_, err = native.FetchTags(context.Background(), "origin")
+		assert.Error(t, err)
+
+		_, err = native.FetchUnshallow(context.Background())
+		assert.Error(t, err)
+
+		err = native.PushTag(context.Background(), "origin", "v1.0.0")
+		assert.Error(t, err)
+
+		err = native.DeleteRemoteTag(context.Background(), "origin", "v1.0.0")
+		assert.Error(t, err)
+	})
+}
+
+func TestNative_SetConfig(t *testing.T) {
+	t.Run("SetConfigReadableByGit", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		chdir(t, dir)
+
+		var native = Native{Dir: dir}
+
+		assert.NoError(t, native.SetConfig(context.Background(), "user.name", "some name"))
+		assert.NoError(t, native.SetConfig(context.Background(), `remote.some-remote.url`, "https://example.com/#repo"))
+
+		var cli = NewCLI("")
+
+		var got, err = cli.GetConfig(context.Background(), "user.name")
+		assert.NoError(t, err)
+		assert.Equal(t, "some name\n", got)
+
+		got, err = cli.GetConfig(context.Background(), "remote.some-remote.url")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/#repo\n", got)
+
+		got, err = native.GetConfig(context.Background(), "remote.some-remote.url")
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/#repo\n", got)
+	})
+}
+
+func TestNative_SetConfigIfNotSet(t *testing.T) {
+	t.Run("DoNotSetConfigIfConfigExists", func(t *testing.T) {
+		var dir = newFixtureRepository(t, false)
+		var native = Native{Dir: dir}
+
+		var got, err = native.SetConfigIfNotSet(context.Background(), "user.name", "other")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "semverbot\n", got)
+	})
+}
+
+func TestNewAPI(t *testing.T) {
+	type Test struct {
+		Backend string
+		Name    string
+		Want    API
+	}
+
+	var tests = []Test{
+		{Name: "SelectCLI", Backend: CLIBackend, Want: CLI{}},
+		{Name: "SelectNative", Backend: NativeBackend, Want: Native{}},
+		{Name: "SelectCLIIfInvalidBackend", Backend: "invalid", Want: CLI{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got = NewAPI(test.Backend, "")
+			assert.IsType(t, test.Want, got)
+		})
+	}
+}
+
+func TestNewNative(t *testing.T) {
+	t.Run("ValidateState", func(t *testing.T) {
+		var native = NewNative("some-dir")
+		assert.NotNil(t, native)
+		assert.NotNil(t, native.shared)
+		assert.Equal(t, "some-dir", native.Dir)
+	})
+}
+
+func TestNative_SharedRepository(t *testing.T) {
+	t.Run("ReuseRepositoryBetweenCalls", func(t *testing.T) {
+		var dir = newFixtureRepository(t, true)
+		var native = NewNative(dir)
+
+		var first, err = native.open(context.Background())
+		require.NoError(t, err)
+
+		var _, tagErr = native.GetLatestAnnotatedTag(context.Background())
+		assert.NoError(t, tagErr)
+
+		var second, _ = native.open(context.Background())
+		assert.Same(t, first, second)
+		assert.NotEmpty(t, second.objects)
+	})
+
+	t.Run("ReadObjectsPackedAfterFirstRead", func(t *testing.T) {
+		var dir = newFixtureRepository(t, true)
+		var native = NewNative(dir)
+
+		var _, err = native.GetLatestAnnotatedTag(context.Background())
+		require.NoError(t, err)
+
+		var run = func(args ...string) {
+			var command = exec.Command("git", args...)
+			command.Dir = dir
+
+			var output, err = command.CombinedOutput()
+			require.NoError(t, err, string(output))
+		}
+
+		run("commit", "--quiet", "--allow-empty", "-m", "new")
+		run("tag", "-a", "v0.11.0", "-m", "v0.11.0")
+		run("gc", "--quiet")
+
+		var got, gotErr = native.GetLatestAnnotatedTag(context.Background())
+
+		assert.NoError(t, gotErr)
+		assert.Equal(t, "v0.11.0\n", got, `want: "%s, got: "%s"`, "v0.11.0\n", got)
+	})
+}
+
+func TestCompareVersions(t *testing.T) {
+	type Test struct {
+		A    string
+		B    string
+		Name string
+		Want int
+	}
+
+	var tests = []Test{
+		{Name: "CompareNumerically", A: "v0.10.0", B: "v0.2.0", Want: 1},
+		{Name: "CompareEqual", A: "v1.0.0", B: "v1.0.0", Want: 0},
+		{Name: "CompareLexically", A: "a1.0.0", B: "b1.0.0", Want: -1},
+		{Name: "CompareLength", A: "v1.0", B: "v1.0.0", Want: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got = compareVersions(test.A, test.B)
+
+			switch {
+			case test.Want > 0:
+				assert.Greater(t, got, 0)
+			case test.Want < 0:
+				assert.Less(t, got, 0)
+			default:
+				assert.Equal(t, 0, got)
+			}
+		})
+	}
+}
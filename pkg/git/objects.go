@@ -0,0 +1,614 @@
+package git
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	objectCommit = "commit"
+	objectTree   = "tree"
+	objectBlob   = "blob"
+	objectTag    = "tag"
+)
+
+// packObjectTypes maps packfile object type numbers to object types.
+var packObjectTypes = map[byte]string{1: objectCommit, 2: objectTree, 3: objectBlob, 4: objectTag}
+
+const (
+	packOfsDelta = 6
+	packRefDelta = 7
+)
+
+// commit a parsed git commit object.
+type commit struct {
+	Message string
+	Parents []string
+	Time    int64
+}
+
+// Subject returns the first paragraph of the commit message joined into a single line, like git's %s format.
+func (c commit) Subject() string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.TrimLeft(c.Message, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			break
+		}
+
+		lines = append(lines, strings.TrimSpace(line))
+	}
+
+	return strings.Join(lines, " ")
+}
+
+// object a decoded git object.
+type object struct {
+	kind string
+	data []byte
+}
+
+// readObject reads an object from the loose object store or from one of the packfiles.
+// Objects never change once written, so decoded objects are cached for the lifetime of the repository.
+// Returns the object type and content or an error if the object does not exist.
+func (repo *repository) readObject(hash string) (kind string, data []byte, err error) {
+	repo.mutex.Lock()
+	var cached, found = repo.objects[hash]
+	repo.mutex.Unlock()
+
+	if found {
+		return cached.kind, cached.data, nil
+	}
+
+	if kind, data, err = repo.readUncachedObject(hash); err != nil {
+		return kind, data, err
+	}
+
+	repo.mutex.Lock()
+	repo.objects[hash] = object{kind: kind, data: data}
+	repo.mutex.Unlock()
+
+	return kind, data, nil
+}
+
+// readUncachedObject reads an object from the loose object store or from one of the packfiles.
+// The packs are reloaded once if the object is missing, in case packs were written since they were loaded.
+// Returns the object type and content or an error if the object does not exist.
+func (repo *repository) readUncachedObject(hash string) (kind string, data []byte, err error) {
+	if kind, data, err = repo.readLooseObject(hash); err == nil || !os.IsNotExist(err) {
+		return kind, data, err
+	}
+
+	var raw []byte
+
+	if raw, err = hex.DecodeString(hash); err != nil {
+		return kind, data, fmt.Errorf("invalid object name '%s'", hash)
+	}
+
+	for _, reload := range []bool{false, true} {
+		var packs []*pack
+
+		if packs, err = repo.loadPacks(reload); err != nil {
+			return kind, data, err
+		}
+
+		for _, p := range packs {
+			if offset, found := p.find(raw); found {
+				return p.readAt(repo, offset)
+			}
+		}
+	}
+
+	return kind, data, fmt.Errorf("object '%s' does not exist", hash)
+}
+
+// readLooseObject reads a zlib compressed object from the objects directory.
+// Returns the object type and content or an error if the object does not exist or is corrupt.
+func (repo *repository) readLooseObject(hash string) (kind string, data []byte, err error) {
+	if len(hash) < 3 {
+		return kind, data, os.ErrNotExist
+	}
+
+	var file *os.File
+
+	if file, err = os.Open(filepath.Join(repo.commonDir, "objects", hash[:2], hash[2:])); err != nil {
+		return kind, data, err
+	}
+
+	defer file.Close()
+
+	var reader io.ReadCloser
+
+	if reader, err = zlib.NewReader(file); err != nil {
+		return kind, data, fmt.Errorf("corrupt loose object '%s': %w", hash, err)
+	}
+
+	defer reader.Close()
+
+	var content []byte
+
+	if content, err = io.ReadAll(reader); err != nil {
+		return kind, data, fmt.Errorf("corrupt loose object '%s': %w", hash, err)
+	}
+
+	var header, body, found = bytes.Cut(content, []byte{0})
+
+	if !found {
+		return kind, data, fmt.Errorf("corrupt loose object '%s': missing header", hash)
+	}
+
+	kind, _, _ = strings.Cut(string(header), " ")
+
+	return kind, body, nil
+}
+
+// readCommit reads and parses a commit, peeling annotated tags if necessary.
+// Returns the parsed commit or an error if the object is not a commit.
+func (repo *repository) readCommit(hash string) (parsed commit, err error) {
+	var kind string
+	var data []byte
+
+	if hash, err = repo.peel(hash); err != nil {
+		return parsed, err
+	}
+
+	if kind, data, err = repo.readObject(hash); err != nil {
+		return parsed, err
+	}
+
+	if kind != objectCommit {
+		return parsed, fmt.Errorf("object '%s' is a %s, not a commit", hash, kind)
+	}
+
+	var headers, message, _ = strings.Cut(string(data), "\n\n")
+
+	for _, line := range strings.Split(headers, "\n") {
+		if value, found := strings.CutPrefix(line, "parent "); found {
+			parsed.Parents = append(parsed.Parents, value)
+		}
+
+		// the committer date is the second to last field, e.g. committer name <email> 1700000000 +0100
+		if value, found := strings.CutPrefix(line, "committer "); found {
+			if fields := strings.Fields(value); len(fields) >= 2 {
+				parsed.Time, _ = strconv.ParseInt(fields[len(fields)-2], 10, 64)
+			}
+		}
+	}
+
+	parsed.Message = message
+
+	return parsed, nil
+}
+
+// peel follows annotated tags until it reaches a non-tag object.
+// Returns the hash of the non-tag object.
+func (repo *repository) peel(hash string) (peeled string, err error) {
+	for {
+		var kind string
+		var data []byte
+
+		if kind, data, err = repo.readObject(hash); err != nil {
+			return peeled, err
+		}
+
+		if kind != objectTag {
+			return hash, nil
+		}
+
+		var target, found = strings.CutPrefix(string(data), "object ")
+
+		if !found || len(target) < 40 {
+			return peeled, fmt.Errorf("malformed tag object '%s'", hash)
+		}
+
+		hash = target[:40]
+	}
+}
+
+// writeObject writes a loose object to the objects directory.
+// Returns the hash of the object or an error if writing failed.
+func (repo *repository) writeObject(kind string, data []byte) (hash string, err error) {
+	var content = append([]byte(fmt.Sprintf("%s %d\x00", kind, len(data))), data...)
+	var sum = sha1.Sum(content)
+
+	hash = hex.EncodeToString(sum[:])
+
+	var path = filepath.Join(repo.commonDir, "objects", hash[:2], hash[2:])
+
+	if _, err = os.Stat(path); err == nil {
+		return hash, nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return hash, err
+	}
+
+	var buffer bytes.Buffer
+	var writer = zlib.NewWriter(&buffer)
+
+	if _, err = writer.Write(content); err != nil {
+		return hash, err
+	}
+
+	if err = writer.Close(); err != nil {
+		return hash, err
+	}
+
+	return hash, writeFileAtomic(path, buffer.Bytes())
+}
+
+// pack a packfile together with its index.
+// The packfile stays open for the lifetime of the pack, it is closed when the pack is garbage collected.
+type pack struct {
+	path    string
+	file    *os.File
+	hashes  [][]byte
+	offsets []int64
+}
+
+// loadPacks loads the indexes of all packfiles once per repository, or the indexes of new packfiles if reloading.
+// Returns the loaded packs or an error if an index or packfile could not be read.
+func (repo *repository) loadPacks(reload bool) (packs []*pack, err error) {
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	if repo.packs != nil && !reload {
+		return repo.packs, nil
+	}
+
+	var paths []string
+
+	if paths, err = filepath.Glob(filepath.Join(repo.commonDir, "objects", "pack", "*.idx")); err != nil {
+		return packs, err
+	}
+
+	var loaded = map[string]bool{}
+
+	for _, p := range repo.packs {
+		loaded[p.path] = true
+	}
+
+	packs = append([]*pack{}, repo.packs...)
+
+	for _, path := range paths {
+		var p *pack
+
+		if loaded[strings.TrimSuffix(path, ".idx")+".pack"] {
+			continue
+		}
+
+		if p, err = readPackIndex(path); err != nil {
+			return packs, err
+		}
+
+		if p.file, err = os.Open(p.path); err != nil {
+			return packs, err
+		}
+
+		packs = append(packs, p)
+	}
+
+	repo.packs = packs
+
+	return packs, nil
+}
+
+// readPackIndex reads a version 1 or version 2 pack index.
+// Returns the pack or an error if the index is corrupt.
+func readPackIndex(path string) (p *pack, err error) {
+	var data []byte
+
+	if data, err = os.ReadFile(path); err != nil {
+		return nil, err
+	}
+
+	p = &pack{path: strings.TrimSuffix(path, ".idx") + ".pack"}
+
+	var corrupt = fmt.Errorf("corrupt pack index '%s'", path)
+
+	if len(data) >= 8 && bytes.Equal(data[:4], []byte{0xff, 't', 'O', 'c'}) {
+		if binary.BigEndian.Uint32(data[4:8]) != 2 {
+			return nil, fmt.Errorf("unsupported pack index version in '%s'", path)
+		}
+
+		if len(data) < 8+256*4 {
+			return nil, corrupt
+		}
+
+		var count = int(binary.BigEndian.Uint32(data[8+255*4:]))
+		var hashesStart = 8 + 256*4
+		var offsetsStart = hashesStart + count*20 + count*4
+		var largeOffsetsStart = offsetsStart + count*4
+
+		if len(data) < largeOffsetsStart {
+			return nil, corrupt
+		}
+
+		for i := 0; i < count; i++ {
+			p.hashes = append(p.hashes, data[hashesStart+i*20:hashesStart+(i+1)*20])
+
+			var offset = int64(binary.BigEndian.Uint32(data[offsetsStart+i*4:]))
+
+			if offset&0x80000000 != 0 {
+				var index = largeOffsetsStart + int(offset&0x7fffffff)*8
+
+				if len(data) < index+8 {
+					return nil, corrupt
+				}
+
+				offset = int64(binary.BigEndian.Uint64(data[index:]))
+			}
+
+			p.offsets = append(p.offsets, offset)
+		}
+
+		return p, nil
+	}
+
+	if len(data) < 256*4 {
+		return nil, corrupt
+	}
+
+	var count = int(binary.BigEndian.Uint32(data[255*4:]))
+
+	if len(data) < 256*4+count*24 {
+		return nil, corrupt
+	}
+
+	for i := 0; i < count; i++ {
+		var entry = data[256*4+i*24:]
+		p.offsets = append(p.offsets, int64(binary.BigEndian.Uint32(entry)))
+		p.hashes = append(p.hashes, entry[4:24])
+	}
+
+	return p, nil
+}
+
+// find looks up the offset of an object in the pack by its raw hash.
+// Returns the offset and true if the object is in the pack.
+func (p *pack) find(hash []byte) (offset int64, found bool) {
+	var index = sort.Search(len(p.hashes), func(i int) bool {
+		return bytes.Compare(p.hashes[i], hash) >= 0
+	})
+
+	if index < len(p.hashes) && bytes.Equal(p.hashes[index], hash) {
+		return p.offsets[index], true
+	}
+
+	return 0, false
+}
+
+// readAt reads the object at an offset in the packfile, resolving deltas against their base objects.
+// Returns the object type and content or an error if the packfile is corrupt.
+func (p *pack) readAt(repo *repository, offset int64) (kind string, data []byte, err error) {
+	return p.readObjectAt(repo, p.file, offset, 0)
+}
+
+// readObjectAt reads the object at an offset from an open packfile.
+func (p *pack) readObjectAt(repo *repository, file *os.File, offset int64, depth int) (kind string, data []byte, err error) {
+	if depth > 64 {
+		return kind, data, fmt.Errorf("delta chain too deep in '%s'", p.path)
+	}
+
+	var reader = &byteReader{reader: io.NewSectionReader(file, offset, 1<<62)}
+	var b byte
+
+	if b, err = reader.ReadByte(); err != nil {
+		return kind, data, err
+	}
+
+	var objectType = (b >> 4) & 7
+
+	// the object size is only needed for validation, zlib tells us where the data ends
+	for b&0x80 != 0 {
+		if b, err = reader.ReadByte(); err != nil {
+			return kind, data, err
+		}
+	}
+
+	switch objectType {
+	case packOfsDelta:
+		var relative int64
+
+		if b, err = reader.ReadByte(); err != nil {
+			return kind, data, err
+		}
+
+		relative = int64(b & 0x7f)
+
+		for b&0x80 != 0 {
+			if b, err = reader.ReadByte(); err != nil {
+				return kind, data, err
+			}
+
+			relative = ((relative + 1) << 7) | int64(b&0x7f)
+		}
+
+		var delta, base []byte
+
+		if delta, err = reader.inflate(); err != nil {
+			return kind, data, err
+		}
+
+		if kind, base, err = p.readObjectAt(repo, file, offset-relative, depth+1); err != nil {
+			return kind, data, err
+		}
+
+		data, err = applyDelta(base, delta)
+
+		return kind, data, err
+	case packRefDelta:
+		var raw = make([]byte, 20)
+
+		if _, err = io.ReadFull(reader, raw); err != nil {
+			return kind, data, err
+		}
+
+		var delta, base []byte
+
+		if delta, err = reader.inflate(); err != nil {
+			return kind, data, err
+		}
+
+		if kind, base, err = repo.readObject(hex.EncodeToString(raw)); err != nil {
+			return kind, data, err
+		}
+
+		data, err = applyDelta(base, delta)
+
+		return kind, data, err
+	default:
+		var exists bool
+
+		if kind, exists = packObjectTypes[objectType]; !exists {
+			return kind, data, fmt.Errorf("unknown object type %d in '%s'", objectType, p.path)
+		}
+
+		data, err = reader.inflate()
+
+		return kind, data, err
+	}
+}
+
+// byteReader a reader which reads single bytes, used to parse packfile object headers.
+type byteReader struct {
+	reader *io.SectionReader
+}
+
+// Read reads from the underlying reader.
+func (r *byteReader) Read(buffer []byte) (int, error) {
+	return r.reader.Read(buffer)
+}
+
+// ReadByte reads a single byte.
+func (r *byteReader) ReadByte() (byte, error) {
+	var buffer [1]byte
+
+	if _, err := io.ReadFull(r.reader, buffer[:]); err != nil {
+		return 0, err
+	}
+
+	return buffer[0], nil
+}
+
+// inflate decompresses zlib data starting at the current position.
+// The byteReader implements io.ByteReader, which prevents zlib from reading ahead.
+func (r *byteReader) inflate() (data []byte, err error) {
+	var reader io.ReadCloser
+
+	if reader, err = zlib.NewReader(r); err != nil {
+		return data, err
+	}
+
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// applyDelta applies a packfile delta to a base object.
+// Returns the resulting object content or an error if the delta is corrupt.
+func applyDelta(base []byte, delta []byte) (result []byte, err error) {
+	var corrupt = fmt.Errorf("corrupt delta")
+	var position = 0
+
+	var readSize = func() (size int, err error) {
+		var shift uint
+
+		for {
+			if position >= len(delta) {
+				return size, corrupt
+			}
+
+			var b = delta[position]
+			position++
+			size |= int(b&0x7f) << shift
+			shift += 7
+
+			if b&0x80 == 0 {
+				return size, nil
+			}
+		}
+	}
+
+	var baseSize, resultSize int
+
+	if baseSize, err = readSize(); err != nil {
+		return result, err
+	}
+
+	if baseSize != len(base) {
+		return result, corrupt
+	}
+
+	if resultSize, err = readSize(); err != nil {
+		return result, err
+	}
+
+	result = make([]byte, 0, resultSize)
+
+	for position < len(delta) {
+		var op = delta[position]
+		position++
+
+		if op&0x80 != 0 {
+			var offset, size int
+
+			for i := uint(0); i < 4; i++ {
+				if op&(1<<i) != 0 {
+					if position >= len(delta) {
+						return result, corrupt
+					}
+
+					offset |= int(delta[position]) << (8 * i)
+					position++
+				}
+			}
+
+			for i := uint(0); i < 3; i++ {
+				if op&(1<<(4+i)) != 0 {
+					if position >= len(delta) {
+						return result, corrupt
+					}
+
+					size |= int(delta[position]) << (8 * i)
+					position++
+				}
+			}
+
+			if size == 0 {
+				size = 0x10000
+			}
+
+			if offset+size > len(base) {
+				return result, corrupt
+			}
+
+			result = append(result, base[offset:offset+size]...)
+		} else if op != 0 {
+			if position+int(op) > len(delta) {
+				return result, corrupt
+			}
+
+			result = append(result, delta[position:position+int(op)]...)
+			position += int(op)
+		} else {
+			return result, corrupt
+		}
+	}
+
+	if len(result) != resultSize {
+		return result, corrupt
+	}
+
+	return result, nil
+}
@@ -0,0 +1,546 @@
+package git
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// repository a git repository on disk, read and written without the git binary.
+type repository struct {
+	// commonDir the directory holding objects, refs and the config, shared by all worktrees.
+	commonDir string
+
+	// gitDir the directory holding HEAD, which differs from commonDir for linked worktrees.
+	gitDir string
+
+	// mutex guards the packs and the objects, since a repository is shared between calls of a Native.
+	mutex   sync.Mutex
+	packs   []*pack
+	objects map[string]object
+}
+
+// openRepository opens the git repository containing a directory.
+// It walks up the directory tree until it finds a .git directory or a .git file pointing to one.
+// Returns the repository or an error if no repository could be found.
+func openRepository(dir string) (repo *repository, err error) {
+	if dir, err = filepath.Abs(dir); err != nil {
+		return nil, err
+	}
+
+	for {
+		var dotGit = filepath.Join(dir, ".git")
+		var info os.FileInfo
+
+		if info, err = os.Stat(dotGit); err == nil {
+			if info.IsDir() {
+				return newRepository(dotGit)
+			}
+
+			return openGitFile(dotGit)
+		}
+
+		var parent = filepath.Dir(dir)
+
+		if parent == dir {
+			return nil, fmt.Errorf("not a git repository (or any of the parent directories): .git")
+		}
+
+		dir = parent
+	}
+}
+
+// openGitFile opens the repository referenced by a .git file, as used by worktrees and submodules.
+// Returns the repository or an error if the file is malformed.
+func openGitFile(path string) (repo *repository, err error) {
+	var content []byte
+
+	if content, err = os.ReadFile(path); err != nil {
+		return nil, err
+	}
+
+	var line = strings.TrimSpace(string(content))
+
+	if !strings.HasPrefix(line, "gitdir: ") {
+		return nil, fmt.Errorf("invalid gitfile format: %s", path)
+	}
+
+	var gitDir = strings.TrimPrefix(line, "gitdir: ")
+
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(path), gitDir)
+	}
+
+	return newRepository(gitDir)
+}
+
+// newRepository creates a new repository for a git directory.
+// Returns the new repository or an error if the commondir file could not be read.
+func newRepository(gitDir string) (repo *repository, err error) {
+	repo = &repository{commonDir: gitDir, gitDir: gitDir, objects: map[string]object{}}
+
+	var content []byte
+
+	if content, err = os.ReadFile(filepath.Join(gitDir, "commondir")); err != nil {
+		if os.IsNotExist(err) {
+			return repo, nil
+		}
+
+		return nil, err
+	}
+
+	var commonDir = strings.TrimSpace(string(content))
+
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(gitDir, commonDir)
+	}
+
+	repo.commonDir = commonDir
+
+	return repo, nil
+}
+
+// refPath returns the path of a loose ref on disk.
+// HEAD and other pseudo refs live in the git directory, everything under refs/ in the common directory.
+func (repo *repository) refPath(name string) string {
+	if strings.HasPrefix(name, "refs/") {
+		return filepath.Join(repo.commonDir, filepath.FromSlash(name))
+	}
+
+	return filepath.Join(repo.gitDir, name)
+}
+
+// resolveRef resolves a ref, following symbolic refs, to an object hash.
+// Returns the object hash or an error if the ref does not exist.
+func (repo *repository) resolveRef(name string) (hash string, err error) {
+	for depth := 0; depth < 5; depth++ {
+		var content []byte
+
+		if content, err = os.ReadFile(repo.refPath(name)); err != nil {
+			if !os.IsNotExist(err) {
+				return hash, err
+			}
+
+			var packed map[string]string
+
+			if packed, err = repo.packedRefs(); err != nil {
+				return hash, err
+			}
+
+			var exists bool
+
+			if hash, exists = packed[name]; !exists {
+				return hash, fmt.Errorf("ref '%s' does not exist", name)
+			}
+
+			return hash, nil
+		}
+
+		var value = strings.TrimSpace(string(content))
+
+		if !strings.HasPrefix(value, "ref: ") {
+			return value, nil
+		}
+
+		name = strings.TrimPrefix(value, "ref: ")
+	}
+
+	return hash, fmt.Errorf("ref '%s' is nested too deeply", name)
+}
+
+// packedRefs reads the packed-refs file.
+// Returns a map of ref names to object hashes, empty if the file does not exist.
+func (repo *repository) packedRefs() (refs map[string]string, err error) {
+	var file *os.File
+
+	refs = map[string]string{}
+
+	if file, err = os.Open(filepath.Join(repo.commonDir, "packed-refs")); err != nil {
+		if os.IsNotExist(err) {
+			return refs, nil
+		}
+
+		return refs, err
+	}
+
+	defer file.Close()
+
+	var scanner = bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var line = scanner.Text()
+
+		// skip comments and peeled lines, tags are peeled when needed
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "^") {
+			continue
+		}
+
+		var fields = strings.Fields(line)
+
+		if len(fields) == 2 {
+			refs[fields[1]] = fields[0]
+		}
+	}
+
+	return refs, scanner.Err()
+}
+
+// listRefs lists all refs with a prefix, e.g. refs/tags/.
+// Loose refs take precedence over packed refs.
+// Returns a map of ref names to object hashes.
+func (repo *repository) listRefs(prefix string) (refs map[string]string, err error) {
+	var packed map[string]string
+
+	if packed, err = repo.packedRefs(); err != nil {
+		return refs, err
+	}
+
+	refs = map[string]string{}
+
+	for name, hash := range packed {
+		if strings.HasPrefix(name, prefix) {
+			refs[name] = hash
+		}
+	}
+
+	var root = filepath.Join(repo.commonDir, filepath.FromSlash(prefix))
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		if entry.IsDir() || strings.HasSuffix(path, ".lock") {
+			return nil
+		}
+
+		var relative string
+
+		if relative, err = filepath.Rel(repo.commonDir, path); err != nil {
+			return err
+		}
+
+		var name = filepath.ToSlash(relative)
+		var hash string
+
+		if hash, err = repo.resolveRef(name); err != nil {
+			return err
+		}
+
+		refs[name] = hash
+
+		return nil
+	})
+
+	return refs, err
+}
+
+// sortedRefNames returns the names of refs in lexical order.
+func sortedRefNames(refs map[string]string) []string {
+	var names = make([]string, 0, len(refs))
+
+	for name := range refs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// refExists returns true if a loose or packed ref exists.
+func (repo *repository) refExists(name string) bool {
+	var _, err = repo.resolveRef(name)
+	return err == nil
+}
+
+// writeRef writes a loose ref pointing to an object hash.
+// Returns an error if the ref could not be written.
+func (repo *repository) writeRef(name string, hash string) (err error) {
+	var path = repo.refPath(name)
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return writeFileAtomic(path, []byte(hash+"\n"))
+}
+
+// deleteRef deletes a loose ref and removes it, including its peeled line, from the packed-refs file.
+// Returns an error if the ref could not be removed.
+func (repo *repository) deleteRef(name string) (err error) {
+	if err = os.Remove(repo.refPath(name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var path = filepath.Join(repo.commonDir, "packed-refs")
+	var content []byte
+
+	if content, err = os.ReadFile(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	var lines = strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	var kept = make([]string, 0, len(lines))
+	var removed = false
+
+	for _, line := range lines {
+		if strings.HasPrefix(line, "^") && removed {
+			continue
+		}
+
+		var fields = strings.Fields(line)
+		removed = len(fields) == 2 && fields[1] == name
+
+		if !removed {
+			kept = append(kept, line)
+		}
+	}
+
+	return writeFileAtomic(path, []byte(strings.Join(kept, "\n")+"\n"))
+}
+
+// writeFileAtomic writes a file by renaming a temporary file into place.
+// Returns an error if writing or renaming failed.
+func writeFileAtomic(path string, content []byte) (err error) {
+	var file *os.File
+
+	if file, err = os.CreateTemp(filepath.Dir(path), ".tmp-"); err != nil {
+		return err
+	}
+
+	if err = file.Chmod(0o644); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	if _, err = file.Write(content); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	if err = file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	if err = os.Rename(file.Name(), path); err != nil {
+		_ = os.Remove(file.Name())
+	}
+
+	return err
+}
+
+// resolveRevision resolves a revision like HEAD, main, v1.0.0, an (abbreviated) object hash or HEAD~2^2 to an object hash.
+// Returns the object hash or an error if the revision could not be resolved.
+func (repo *repository) resolveRevision(revision string) (hash string, err error) {
+	var name, operators = revision, ""
+
+	if index := strings.IndexAny(revision, "^~"); index >= 0 {
+		name, operators = revision[:index], revision[index:]
+	}
+
+	if hash, err = repo.resolveName(name); err != nil {
+		return hash, err
+	}
+
+	for len(operators) > 0 {
+		var operator = operators[0]
+		var digits = 1
+
+		for digits < len(operators) && isDigit(operators[digits]) {
+			digits++
+		}
+
+		var count = 1
+
+		if digits > 1 {
+			if count, err = strconv.Atoi(operators[1:digits]); err != nil {
+				return hash, err
+			}
+		}
+
+		operators = operators[digits:]
+
+		var parsed commit
+
+		switch {
+		case operator == '^' && count == 0:
+			if hash, err = repo.peel(hash); err != nil {
+				return hash, err
+			}
+		case operator == '^':
+			if parsed, err = repo.readCommit(hash); err != nil {
+				return hash, err
+			}
+
+			if len(parsed.Parents) < count {
+				return hash, fmt.Errorf("revision '%s' does not exist", revision)
+			}
+
+			hash = parsed.Parents[count-1]
+		default:
+			for i := 0; i < count; i++ {
+				if parsed, err = repo.readCommit(hash); err != nil {
+					return hash, err
+				}
+
+				if len(parsed.Parents) == 0 {
+					return hash, fmt.Errorf("revision '%s' does not exist", revision)
+				}
+
+				hash = parsed.Parents[0]
+			}
+		}
+	}
+
+	return hash, nil
+}
+
+// resolveName resolves a ref name or an (abbreviated) object hash to an object hash.
+// Ref names are looked up in the same order as git does, e.g. tags before branches.
+// Returns the object hash or an error if the name is unknown or ambiguous.
+func (repo *repository) resolveName(name string) (hash string, err error) {
+	if name == "" {
+		return hash, fmt.Errorf("empty revision")
+	}
+
+	var candidates = []string{"refs/" + name, "refs/tags/" + name, "refs/heads/" + name, "refs/remotes/" + name, "refs/remotes/" + name + "/HEAD"}
+
+	// pseudo refs like HEAD and ORIG_HEAD live in the git directory
+	if strings.ToUpper(name) == name || strings.HasPrefix(name, "refs/") {
+		candidates = append([]string{name}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		if hash, err = repo.resolveRef(candidate); err == nil {
+			return hash, nil
+		}
+	}
+
+	if !isHex(name) || len(name) < 4 {
+		return hash, fmt.Errorf("revision '%s' does not exist", name)
+	}
+
+	var matches []string
+
+	if matches, err = repo.findObjects(strings.ToLower(name)); err != nil {
+		return hash, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return hash, fmt.Errorf("revision '%s' does not exist", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return hash, fmt.Errorf("revision '%s' is ambiguous", name)
+	}
+}
+
+// shortName gets the short name of a ref name, following HEAD to the branch it points to.
+// Returns the short name, HEAD if HEAD is detached or an empty string if the name is not a ref.
+func (repo *repository) shortName(name string) (short string, err error) {
+	if name == HEAD {
+		var content []byte
+
+		if content, err = os.ReadFile(repo.refPath(HEAD)); err != nil {
+			return short, err
+		}
+
+		var target, symbolic = strings.CutPrefix(strings.TrimSpace(string(content)), "ref: ")
+
+		if !symbolic {
+			return HEAD, nil
+		}
+
+		name = target
+	}
+
+	if strings.HasPrefix(name, "refs/") {
+		if !repo.refExists(name) {
+			return short, nil
+		}
+
+		for _, prefix := range []string{"refs/heads/", "refs/tags/", "refs/remotes/", "refs/"} {
+			if short, found := strings.CutPrefix(name, prefix); found {
+				return short, nil
+			}
+		}
+	}
+
+	for _, prefix := range []string{"refs/", "refs/tags/", "refs/heads/", "refs/remotes/"} {
+		if repo.refExists(prefix + name) {
+			return name, nil
+		}
+	}
+
+	return short, nil
+}
+
+// findObjects finds the hashes of all loose and packed objects starting with a hex prefix.
+// Returns the unique matching object hashes.
+func (repo *repository) findObjects(prefix string) (matches []string, err error) {
+	var found = map[string]bool{}
+	var entries []os.DirEntry
+
+	if entries, err = os.ReadDir(filepath.Join(repo.commonDir, "objects", prefix[:2])); err != nil && !os.IsNotExist(err) {
+		return matches, err
+	}
+
+	for _, entry := range entries {
+		if hash := prefix[:2] + entry.Name(); strings.HasPrefix(hash, prefix) {
+			found[hash] = true
+		}
+	}
+
+	var packs []*pack
+
+	if packs, err = repo.loadPacks(true); err != nil {
+		return matches, err
+	}
+
+	for _, p := range packs {
+		for _, raw := range p.hashes {
+			if hash := hex.EncodeToString(raw); strings.HasPrefix(hash, prefix) {
+				found[hash] = true
+			}
+		}
+	}
+
+	for hash := range found {
+		matches = append(matches, hash)
+	}
+
+	sort.Strings(matches)
+
+	return matches, nil
+}
+
+// isHex returns true if a string only contains hexadecimal characters.
+func isHex(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if !isDigit(value[i]) && !strings.ContainsRune("abcdefABCDEF", rune(value[i])) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/restechnica/semverbot/internal/mocks"
+	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/semver"
 )
 
@@ -36,8 +38,8 @@ func TestAPI_SelectMode(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			var gitBranchMode = NewGitBranchMode(gitBranchDelimiters, semverMap)
-			var gitCommitMode = NewGitCommitMode(gitCommitDelimiters, semverMap)
+			var gitBranchMode = NewGitBranchMode(gitBranchDelimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
+			var gitCommitMode = NewGitCommitMode(gitCommitDelimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
 
 			var modeAPI = NewAPI(gitBranchMode, gitCommitMode)
 			var got = modeAPI.SelectMode(test.Mode)
@@ -53,8 +55,8 @@ func TestNewAPI(t *testing.T) {
 		var gitCommitDelimiters = "[]"
 
 		var semverMap = semver.Map{}
-		var gitBranchMode = NewGitBranchMode(gitBranchDelimiters, semverMap)
-		var gitCommitMode = NewGitCommitMode(gitCommitDelimiters, semverMap)
+		var gitBranchMode = NewGitBranchMode(gitBranchDelimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
+		var gitCommitMode = NewGitCommitMode(gitCommitDelimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
 		var modeAPI = NewAPI(gitBranchMode, gitCommitMode)
 
 		assert.NotNil(t, modeAPI)
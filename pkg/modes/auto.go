@@ -1,6 +1,10 @@
 package modes
 
-import "github.com/rs/zerolog/log"
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
 
 // Auto mode name for AutoMode.
 const Auto = "auto"
@@ -21,18 +25,40 @@ func NewAutoMode(modes []Mode) AutoMode {
 // Increment increments a given version using AutoMode.
 // It will attempt to increment the target version with its internal modes and defaults to PatchMode as a last resort.
 // Returns the incremented version or an error if anything went wrong.
-func (autoMode AutoMode) Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error) {
+func (autoMode AutoMode) Increment(ctx context.Context, targetVersion string) (nextVersion string, err error) {
+	var explanation = autoMode.Explain(ctx, targetVersion)
+	return explanation.Version, explanation.Err
+}
+
+// Explain explains how AutoMode increments a given version, including the explanation of each mode it tried.
+// Returns the explanation, with an error if anything went wrong.
+func (autoMode AutoMode) Explain(ctx context.Context, targetVersion string) (explanation Explanation) {
+	explanation = Explanation{Mode: Auto}
+
 	for _, mode := range autoMode.Modes {
-		if nextVersion, err = mode.Increment(prefix, suffix, targetVersion); err == nil {
-			return nextVersion, err
+		var tried = Explain(ctx, mode, targetVersion)
+
+		explanation.Tried = append(explanation.Tried, tried)
+
+		if tried.Err == nil {
+			explanation.Detected = tried.Detected
+			explanation.Version = tried.Version
+			return explanation
 		}
 
-		log.Debug().Err(err).Msgf("tried %s", mode)
+		log.Debug().Err(tried.Err).Msgf("tried %s", mode)
 	}
 
 	log.Warn().Msg("falling back to patch mode")
 
-	return PatchMode{}.Increment(prefix, suffix, targetVersion)
+	var fallback = Explain(ctx, PatchMode{}, targetVersion)
+	fallback.Fallback = true
+
+	explanation.Tried = append(explanation.Tried, fallback)
+	explanation.Detected = fallback.Detected
+	explanation.Version, explanation.Err = fallback.Version, fallback.Err
+
+	return explanation.withError()
 }
 
 // String returns a string representation of an instance.
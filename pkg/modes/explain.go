@@ -0,0 +1,101 @@
+package modes
+
+import (
+	"context"
+	"strings"
+
+	"github.com/restechnica/semverbot/internal/util"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+// Explanation explains how a mode incremented a version or why it failed to.
+// Source describes the input, e.g. the commit message, which is split by the delimiters into tokens
+// that are matched against the values of the semver map.
+// Tried holds the explanations of the modes an AutoMode tried in order, where the last one is a Fallback if all of them failed.
+type Explanation struct {
+	Mode       string        `json:"mode"`
+	Source     string        `json:"source,omitempty"`
+	Input      string        `json:"input,omitempty"`
+	Delimiters string        `json:"delimiters,omitempty"`
+	Tokens     []string      `json:"tokens,omitempty"`
+	SemverMap  semver.Map    `json:"semver,omitempty"`
+	Matches    []Match       `json:"matches,omitempty"`
+	Detected   string        `json:"detected,omitempty"`
+	Version    string        `json:"version,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Tried      []Explanation `json:"tried,omitempty"`
+	Fallback   bool          `json:"fallback,omitempty"`
+	Err        error         `json:"-"`
+}
+
+// Match a token which matched one of the values of a semver level in the semver map.
+type Match struct {
+	Token string `json:"token"`
+	Level string `json:"level"`
+}
+
+// Explainer a Mode which explains how it increments a version.
+type Explainer interface {
+	Explain(ctx context.Context, targetVersion string) Explanation
+}
+
+// Explain explains how a mode increments a version.
+// Modes which do not implement Explainer are explained by their increment alone.
+// Returns the explanation.
+func Explain(ctx context.Context, mode Mode, targetVersion string) Explanation {
+	if explainer, ok := mode.(Explainer); ok {
+		return explainer.Explain(ctx, targetVersion)
+	}
+
+	var explanation = Explanation{Mode: mode.String()}
+
+	if explanation.Version, explanation.Err = mode.Increment(ctx, targetVersion); explanation.Err == nil {
+		explanation.Detected = explanation.Mode
+	}
+
+	return explanation.withError()
+}
+
+// levels the semver levels a mode can be detected for, in increasing order.
+var levels = []string{Patch, Minor, Major}
+
+// explainDetection explains the detection of a mode from an input and the increment of a version with the detected mode.
+// The input is trimmed first, so the last token does not end with the newline git prints after a commit message.
+// Returns the explanation.
+func explainDetection(ctx context.Context, explanation Explanation, input string, semverMap semver.Map, targetVersion string) Explanation {
+	var detected Mode
+
+	input = strings.TrimSpace(input)
+
+	explanation.Input = input
+	explanation.SemverMap = semverMap
+	explanation.Tokens = util.SplitByDelimiterString(input, explanation.Delimiters)
+
+	for _, token := range explanation.Tokens {
+		// a map has no order, so the levels are iterated in a fixed order to keep the output stable
+		for _, level := range levels {
+			if util.SliceContainsString(semverMap[level], token) {
+				explanation.Matches = append(explanation.Matches, Match{Token: token, Level: level})
+			}
+		}
+	}
+
+	if detected, explanation.Err = DetectModeFromString(input, semverMap, explanation.Delimiters); explanation.Err != nil {
+		return explanation.withError()
+	}
+
+	explanation.Detected = detected.String()
+	explanation.Version, explanation.Err = detected.Increment(ctx, targetVersion)
+
+	return explanation.withError()
+}
+
+// withError sets the error message of an explanation if it has an error.
+// Returns the explanation.
+func (explanation Explanation) withError() Explanation {
+	if explanation.Err != nil {
+		explanation.Error = explanation.Err.Error()
+	}
+
+	return explanation
+}
@@ -0,0 +1,125 @@
+package modes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/restechnica/semverbot/internal/mocks"
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+func TestExplain(t *testing.T) {
+	var semverMap = semver.Map{
+		Patch: {"fix", "bug"},
+		Minor: {"feature"},
+		Major: {"release"},
+	}
+
+	t.Run("ExplainGitCommitMode", func(t *testing.T) {
+		var want = Explanation{
+			Mode:       GitCommit,
+			Source:     "commit message",
+			Input:      "[feature] some-fix",
+			Delimiters: "[]",
+			Tokens:     []string{"feature", " some-fix"},
+			SemverMap:  semverMap,
+			Matches:    []Match{{Token: "feature", Level: Minor}},
+			Detected:   Minor,
+			Version:    "0.1.0",
+		}
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("[feature] some-fix", nil)
+
+		var got = Explain(context.Background(), NewGitCommitMode("[]", semverMap, gitAPI, git.HEAD), "0.0.1")
+
+		assert.Equal(t, want, got, `want: "%v", got: "%v"`, want, got)
+	})
+
+	t.Run("TrimInputBeforeSplitting", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("some fix\n", nil)
+
+		var got = Explain(context.Background(), NewGitCommitMode(" ", semverMap, gitAPI, git.HEAD), "0.0.1")
+
+		assert.NoError(t, got.Err)
+		assert.Equal(t, "some fix", got.Input)
+		assert.Equal(t, []string{"some", "fix"}, got.Tokens)
+		assert.Equal(t, []Match{{Token: "fix", Level: Patch}}, got.Matches)
+	})
+
+	t.Run("ExplainMatchesInLevelOrder", func(t *testing.T) {
+		var ambiguous = semver.Map{Major: {"change"}, Patch: {"change"}, Minor: {"change"}}
+		var want = []Match{{Token: "change", Level: Patch}, {Token: "change", Level: Minor}, {Token: "change", Level: Major}}
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("[change]", nil)
+
+		for i := 0; i < 10; i++ {
+			var got = Explain(context.Background(), NewGitCommitMode("[]", ambiguous, gitAPI, git.HEAD), "0.0.1")
+			assert.Equal(t, want, got.Matches, `want: "%v", got: "%v"`, want, got.Matches)
+		}
+	})
+
+	t.Run("ExplainGitBranchModeWithoutMerge", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("", nil)
+
+		var got = Explain(context.Background(), NewGitBranchMode("/", semverMap, gitAPI, git.HEAD), "0.0.1")
+
+		assert.Error(t, got.Err)
+		assert.Equal(t, got.Err.Error(), got.Error)
+		assert.Empty(t, got.Tokens)
+	})
+
+	t.Run("ExplainUndetectedMode", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("some/branch", nil)
+
+		var got = Explain(context.Background(), NewGitBranchMode("/", semverMap, gitAPI, git.HEAD), "0.0.1")
+
+		assert.Error(t, got.Err)
+		assert.Equal(t, []string{"some", "branch"}, got.Tokens)
+		assert.Empty(t, got.Matches)
+		assert.Empty(t, got.Detected)
+	})
+
+	t.Run("ExplainModeWithoutExplainer", func(t *testing.T) {
+		var want = Explanation{Mode: Major, Detected: Major, Version: "1.0.0"}
+		var got = Explain(context.Background(), NewMajorMode(), "0.1.0")
+
+		assert.Equal(t, want, got, `want: "%v", got: "%v"`, want, got)
+	})
+
+	t.Run("ExplainAutoModeFallback", func(t *testing.T) {
+		var mockMode = mocks.NewMockMode()
+		mockMode.On("Increment", mock.Anything).Return("", fmt.Errorf("some-error"))
+
+		var got = Explain(context.Background(), NewAutoMode([]Mode{mockMode}), "0.0.1")
+
+		assert.NoError(t, got.Err)
+		assert.Equal(t, "0.0.2", got.Version)
+		assert.Equal(t, Patch, got.Detected)
+		assert.Len(t, got.Tried, 2)
+		assert.Equal(t, "some-error", got.Tried[0].Error)
+		assert.True(t, got.Tried[1].Fallback)
+	})
+
+	t.Run("ExplainAutoModeStopsAtFirstMatch", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("[release] some-change", nil)
+
+		var commitMode = NewGitCommitMode("[]", semverMap, gitAPI, git.HEAD)
+		var got = Explain(context.Background(), NewAutoMode([]Mode{commitMode, NewMinorMode()}), "0.1.0")
+
+		assert.NoError(t, got.Err)
+		assert.Equal(t, "1.0.0", got.Version)
+		assert.Equal(t, Major, got.Detected)
+		assert.Len(t, got.Tried, 1)
+	})
+}
@@ -1,6 +1,7 @@
 package modes
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/restechnica/semverbot/pkg/git"
@@ -11,41 +12,47 @@ import (
 const GitBranch = "git-branch"
 
 // GitBranchMode implementation of the Mode interface.
-// It increments the semver level based on the naming of the source branch of a git merge.
+// It increments the semver level based on the naming of the source branch of a git merge on a ref, e.g. HEAD.
 type GitBranchMode struct {
 	Delimiters string
 	GitAPI     git.API
+	Ref        string
 	SemverMap  semver.Map
 }
 
 // NewGitBranchMode creates a new GitBranchMode.
 // Returns the new GitBranchMode.
-func NewGitBranchMode(delimiters string, semverMap semver.Map) GitBranchMode {
-	return GitBranchMode{Delimiters: delimiters, GitAPI: git.NewCLI(), SemverMap: semverMap}
+func NewGitBranchMode(delimiters string, semverMap semver.Map, gitAPI git.API, ref string) GitBranchMode {
+	return GitBranchMode{Delimiters: delimiters, GitAPI: gitAPI, Ref: ref, SemverMap: semverMap}
 }
 
 // Increment increments the semver level based on the naming of the source branch of a git merge.
-// Returns the incremented version or an error if the last git commit is not a merge or if no mode was detected
+// Returns the incremented version or an error if the git commit of the ref is not a merge or if no mode was detected
 // based on the branch name.
-func (mode GitBranchMode) Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error) {
+func (mode GitBranchMode) Increment(ctx context.Context, targetVersion string) (nextVersion string, err error) {
+	var explanation = mode.Explain(ctx, targetVersion)
+	return explanation.Version, explanation.Err
+}
+
+// Explain explains how the semver level is detected from the naming of the source branch of a git merge.
+// Returns the explanation, with an error if the git commit of the ref is not a merge or if no mode was detected.
+func (mode GitBranchMode) Explain(ctx context.Context, targetVersion string) (explanation Explanation) {
 	var branchName string
-	var matchedMode Mode
 
-	if branchName, err = mode.GitAPI.GetMergedBranchName(); err != nil {
-		return
+	explanation = Explanation{Mode: GitBranch, Source: "merged branch", Delimiters: mode.Delimiters}
+
+	if branchName, explanation.Err = mode.GitAPI.GetMergedBranchName(ctx, mode.Ref); explanation.Err != nil {
+		return explanation.withError()
 	}
 
 	var isMergeCommit = branchName != ""
 
 	if !isMergeCommit {
-		return nextVersion, fmt.Errorf("failed to increment version because the latest git commit is not a merge commit")
-	}
-
-	if matchedMode, err = DetectModeFromString(branchName, mode.SemverMap, mode.Delimiters); err != nil {
-		return nextVersion, err
+		explanation.Err = fmt.Errorf("failed to increment version because the latest git commit is not a merge commit")
+		return explanation.withError()
 	}
 
-	return matchedMode.Increment(prefix, suffix, targetVersion)
+	return explainDetection(ctx, explanation, branchName, mode.SemverMap, targetVersion)
 }
 
 // String returns a string representation of an instance.
@@ -1,12 +1,14 @@
 package modes
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/restechnica/semverbot/internal/mocks"
+	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/semver"
 )
 
@@ -30,28 +32,25 @@ func TestGitBranchMode_Increment(t *testing.T) {
 		BranchName string
 		Delimiters string
 		Name       string
-		Prefix     string
-		Suffix     string
 		SemverMap  semver.Map
 		Version    string
 		Want       string
 	}
 
 	var tests = []Test{
-		{Name: "IncrementPatch", BranchName: "fix/some-bug", Delimiters: "/", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
-		{Name: "IncrementMinor", BranchName: "feature/some-bug", Delimiters: "/", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.0.1", Want: "0.1.0"},
-		{Name: "IncrementMajor", BranchName: "release/some-bug", Delimiters: "/", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.1.0", Want: "1.0.0"},
+		{Name: "IncrementPatch", BranchName: "fix/some-bug", Delimiters: "/", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
+		{Name: "IncrementMinor", BranchName: "feature/some-bug", Delimiters: "/", SemverMap: semverMap, Version: "0.0.1", Want: "0.1.0"},
+		{Name: "IncrementMajor", BranchName: "release/some-bug", Delimiters: "/", SemverMap: semverMap, Version: "0.1.0", Want: "1.0.0"},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var gitAPI = mocks.NewMockGitAPI()
-			gitAPI.On("GetMergedBranchName").Return(test.BranchName, nil)
+			gitAPI.On("GetMergedBranchName", git.HEAD).Return(test.BranchName, nil)
 
-			var mode = NewGitBranchMode(test.Delimiters, test.SemverMap)
-			mode.GitAPI = gitAPI
+			var mode = NewGitBranchMode(test.Delimiters, test.SemverMap, gitAPI, git.HEAD)
 
-			var got, err = mode.Increment(test.Prefix, test.Suffix, test.Version)
+			var got, err = mode.Increment(context.Background(), test.Version)
 
 			assert.NoError(t, err)
 			assert.IsType(t, test.Want, got, `want: '%s, got: '%s'`, test.Want, got)
@@ -62,12 +61,11 @@ func TestGitBranchMode_Increment(t *testing.T) {
 		var want = fmt.Errorf("some-error")
 
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetMergedBranchName").Return("", want)
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("", want)
 
-		var mode = NewGitBranchMode("/", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitBranchMode("/", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "0.0.0")
+		var _, got = mode.Increment(context.Background(), "0.0.0")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: '%s, got: '%s'`, want, got)
@@ -77,12 +75,11 @@ func TestGitBranchMode_Increment(t *testing.T) {
 		var want = fmt.Errorf("failed to increment version because the latest git commit is not a merge commit")
 
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetMergedBranchName").Return("", nil)
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("", nil)
 
-		var mode = NewGitBranchMode("/", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitBranchMode("/", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "0.0.0")
+		var _, got = mode.Increment(context.Background(), "0.0.0")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: '%s, got: '%s'`, want, got)
@@ -90,24 +87,22 @@ func TestGitBranchMode_Increment(t *testing.T) {
 
 	t.Run("ReturnErrorIfNoMatchingMode", func(t *testing.T) {
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetMergedBranchName").Return("feat/some-feature", nil)
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("feat/some-feature", nil)
 
-		var mode = NewGitBranchMode("/", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitBranchMode("/", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "0.0.0")
+		var _, got = mode.Increment(context.Background(), "0.0.0")
 
 		assert.Error(t, got)
 	})
 
 	t.Run("ReturnErrorIfInvalidVersion", func(t *testing.T) {
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetMergedBranchName").Return("feature/some-feat", nil)
+		gitAPI.On("GetMergedBranchName", git.HEAD).Return("feature/some-feat", nil)
 
-		var mode = NewGitBranchMode("/", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitBranchMode("/", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "invalid")
+		var _, got = mode.Increment(context.Background(), "invalid")
 
 		assert.Error(t, got)
 	})
@@ -115,7 +110,7 @@ func TestGitBranchMode_Increment(t *testing.T) {
 
 func TestGitBranchMode_String(t *testing.T) {
 	t.Run("ShouldEqualConstant", func(t *testing.T) {
-		var mode = NewGitBranchMode("", semver.Map{})
+		var mode = NewGitBranchMode("", semver.Map{}, mocks.NewMockGitAPI(), git.HEAD)
 		var got = mode.String()
 		var want = GitBranch
 
@@ -127,10 +122,11 @@ func TestNewGitBranchMode(t *testing.T) {
 	t.Run("ValidateState", func(t *testing.T) {
 		var delimiters = "/"
 		var semverMap = semver.Map{}
-		var mode = NewGitBranchMode(delimiters, semverMap)
+		var mode = NewGitBranchMode(delimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
 
 		assert.NotNil(t, mode)
 		assert.NotEmpty(t, mode.Delimiters)
+		assert.NotEmpty(t, mode.Ref)
 		assert.NotNil(t, mode.SemverMap)
 	})
 }
@@ -1,6 +1,8 @@
 package modes
 
 import (
+	"context"
+
 	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/semver"
 )
@@ -9,34 +11,39 @@ import (
 const GitCommit = "git-commit"
 
 // GitCommitMode implementation of the Mode interface.
-// It increments the semver level based on the latest git commit messages.
+// It increments the semver level based on the git commit message of a ref, e.g. HEAD.
 type GitCommitMode struct {
 	Delimiters string
 	GitAPI     git.API
+	Ref        string
 	SemverMap  semver.Map
 }
 
 // NewGitCommitMode creates a new GitCommitMode.
 // Returns the new GitCommitMode.
-func NewGitCommitMode(delimiters string, semverMap semver.Map) GitCommitMode {
-	return GitCommitMode{Delimiters: delimiters, GitAPI: git.NewCLI(), SemverMap: semverMap}
+func NewGitCommitMode(delimiters string, semverMap semver.Map, gitAPI git.API, ref string) GitCommitMode {
+	return GitCommitMode{Delimiters: delimiters, GitAPI: gitAPI, Ref: ref, SemverMap: semverMap}
 }
 
-// Increment increments a given version based on the latest git commit message.
+// Increment increments a given version based on the git commit message of the ref.
 // Returns the incremented version or an error if it failed to detect the mode based on the git commit.
-func (mode GitCommitMode) Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error) {
+func (mode GitCommitMode) Increment(ctx context.Context, targetVersion string) (nextVersion string, err error) {
+	var explanation = mode.Explain(ctx, targetVersion)
+	return explanation.Version, explanation.Err
+}
+
+// Explain explains how the semver level is detected from the git commit message of the ref.
+// Returns the explanation, with an error if it failed to detect the mode based on the git commit.
+func (mode GitCommitMode) Explain(ctx context.Context, targetVersion string) (explanation Explanation) {
 	var message string
-	var detectedMode Mode
 
-	if message, err = mode.GitAPI.GetLatestCommitMessage(); err != nil {
-		return
-	}
+	explanation = Explanation{Mode: GitCommit, Source: "commit message", Delimiters: mode.Delimiters}
 
-	if detectedMode, err = DetectModeFromString(message, mode.SemverMap, mode.Delimiters); err != nil {
-		return
+	if message, explanation.Err = mode.GitAPI.GetLatestCommitMessage(ctx, mode.Ref); explanation.Err != nil {
+		return explanation.withError()
 	}
 
-	return detectedMode.Increment(prefix, suffix, targetVersion)
+	return explainDetection(ctx, explanation, message, mode.SemverMap, targetVersion)
 }
 
 // String returns a string representation of an instance.
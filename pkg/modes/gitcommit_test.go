@@ -1,12 +1,14 @@
 package modes
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/restechnica/semverbot/internal/mocks"
+	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/semver"
 )
 
@@ -30,29 +32,26 @@ func TestGitCommitMode_Increment(t *testing.T) {
 		CommitMessage string
 		Delimiters    string
 		Name          string
-		Prefix        string
-		Suffix        string
 		SemverMap     semver.Map
 		Version       string
 		Want          string
 	}
 
 	var tests = []Test{
-		{Name: "IncrementPatch", CommitMessage: "fix] some-bug", Delimiters: "[]", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
-		{Name: "IncrementPatch", CommitMessage: "[fi] some/bug", Delimiters: "/", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
-		{Name: "IncrementMinor", CommitMessage: "[feature] some-feat", Delimiters: "[]", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.0.1", Want: "0.1.0"},
-		{Name: "IncrementMajor", CommitMessage: "[release] some-release", Delimiters: "[]", Prefix: "v", Suffix: "", SemverMap: semverMap, Version: "0.1.0", Want: "1.0.0"},
+		{Name: "IncrementPatch", CommitMessage: "fix] some-bug", Delimiters: "[]", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
+		{Name: "IncrementPatch", CommitMessage: "[fi] some/bug", Delimiters: "/", SemverMap: semverMap, Version: "0.0.0", Want: "0.0.1"},
+		{Name: "IncrementMinor", CommitMessage: "[feature] some-feat", Delimiters: "[]", SemverMap: semverMap, Version: "0.0.1", Want: "0.1.0"},
+		{Name: "IncrementMajor", CommitMessage: "[release] some-release", Delimiters: "[]", SemverMap: semverMap, Version: "0.1.0", Want: "1.0.0"},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var gitAPI = mocks.NewMockGitAPI()
-			gitAPI.On("GetLatestCommitMessage").Return(test.CommitMessage, nil)
+			gitAPI.On("GetLatestCommitMessage", git.HEAD).Return(test.CommitMessage, nil)
 
-			var mode = NewGitCommitMode(test.Delimiters, test.SemverMap)
-			mode.GitAPI = gitAPI
+			var mode = NewGitCommitMode(test.Delimiters, test.SemverMap, gitAPI, git.HEAD)
 
-			var got, err = mode.Increment(test.Prefix, test.Suffix, test.Version)
+			var got, err = mode.Increment(context.Background(), test.Version)
 
 			assert.NoError(t, err)
 			assert.IsType(t, test.Want, got, `want: '%s, got: '%s'`, test.Want, got)
@@ -63,12 +62,11 @@ func TestGitCommitMode_Increment(t *testing.T) {
 		var want = fmt.Errorf("some-error")
 
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetLatestCommitMessage").Return("", want)
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("", want)
 
-		var mode = NewGitCommitMode("[]", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitCommitMode("[]", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "0.0.0")
+		var _, got = mode.Increment(context.Background(), "0.0.0")
 
 		assert.Error(t, got)
 		assert.Equal(t, want, got, `want: '%s, got: '%s'`, want, got)
@@ -76,24 +74,22 @@ func TestGitCommitMode_Increment(t *testing.T) {
 
 	t.Run("ReturnErrorIfNoMatchingMode", func(t *testing.T) {
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetLatestCommitMessage").Return("nomatch/some-feature", nil)
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("nomatch/some-feature", nil)
 
-		var mode = NewGitCommitMode("/", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitCommitMode("/", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "0.0.0")
+		var _, got = mode.Increment(context.Background(), "0.0.0")
 
 		assert.Error(t, got)
 	})
 
 	t.Run("ReturnErrorIfInvalidVersion", func(t *testing.T) {
 		var gitAPI = mocks.NewMockGitAPI()
-		gitAPI.On("GetLatestCommitMessage").Return("[feature]some-feature", nil)
+		gitAPI.On("GetLatestCommitMessage", git.HEAD).Return("[feature]some-feature", nil)
 
-		var mode = NewGitCommitMode("[]", semverMap)
-		mode.GitAPI = gitAPI
+		var mode = NewGitCommitMode("[]", semverMap, gitAPI, git.HEAD)
 
-		var _, got = mode.Increment("v", "", "invalid")
+		var _, got = mode.Increment(context.Background(), "invalid")
 
 		assert.Error(t, got)
 	})
@@ -101,7 +97,7 @@ func TestGitCommitMode_Increment(t *testing.T) {
 
 func TestGitCommitMode_String(t *testing.T) {
 	t.Run("ShouldEqualConstant", func(t *testing.T) {
-		var mode = NewGitCommitMode("", semver.Map{})
+		var mode = NewGitCommitMode("", semver.Map{}, mocks.NewMockGitAPI(), git.HEAD)
 		var got = mode.String()
 		var want = GitCommit
 
@@ -113,10 +109,11 @@ func TestNewGitCommitMode(t *testing.T) {
 	t.Run("ValidateState", func(t *testing.T) {
 		var delimiters = "[]"
 		var semverMap = semver.Map{}
-		var mode = NewGitCommitMode(delimiters, semverMap)
+		var mode = NewGitCommitMode(delimiters, semverMap, mocks.NewMockGitAPI(), git.HEAD)
 
 		assert.NotNil(t, mode)
 		assert.NotEmpty(t, mode.Delimiters)
+		assert.NotEmpty(t, mode.Ref)
 		assert.NotNil(t, mode.SemverMap)
 	})
 }
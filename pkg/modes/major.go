@@ -1,6 +1,8 @@
 package modes
 
 import (
+	"context"
+
 	blangsemver "github.com/blang/semver/v4"
 	"github.com/restechnica/semverbot/pkg/semver"
 )
@@ -20,10 +22,10 @@ func NewMajorMode() MajorMode {
 
 // Increment increments a given version using the MajorMode.
 // Returns the incremented version.
-func (mode MajorMode) Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error) {
+func (mode MajorMode) Increment(ctx context.Context, targetVersion string) (nextVersion string, err error) {
 	var version blangsemver.Version
 
-	if version, err = semver.Parse(prefix, suffix, targetVersion); err != nil {
+	if version, err = semver.Parse(targetVersion); err != nil {
 		return
 	}
 
@@ -1,7 +1,9 @@
 package modes
 
+import "context"
+
 // Mode interface which increments a specific semver level.
 type Mode interface {
-	Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error)
+	Increment(ctx context.Context, targetVersion string) (nextVersion string, err error)
 	String() string
 }
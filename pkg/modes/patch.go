@@ -1,6 +1,8 @@
 package modes
 
 import (
+	"context"
+
 	blangsemver "github.com/blang/semver/v4"
 
 	"github.com/restechnica/semverbot/pkg/semver"
@@ -21,10 +23,10 @@ func NewPatchMode() PatchMode {
 
 // Increment increments a given version using the PatchMode.
 // Returns the incremented version.
-func (mode PatchMode) Increment(prefix string, suffix string, targetVersion string) (nextVersion string, err error) {
+func (mode PatchMode) Increment(ctx context.Context, targetVersion string) (nextVersion string, err error) {
 	var version blangsemver.Version
 
-	if version, err = semver.Parse(prefix, suffix, targetVersion); err != nil {
+	if version, err = semver.Parse(targetVersion); err != nil {
 		return
 	}
 
@@ -2,50 +2,42 @@ package semver
 
 import (
 	"fmt"
-	"regexp"
-	"strings"
+	"sort"
 
 	blangsemver "github.com/blang/semver/v4"
 )
 
-// Find finds the biggest valid semver version in a slice of strings.
-// The initial order of the versions does not matter.
-// Returns the biggest valid semver version if found, otherwise an error stating no valid semver version has been found.
-func Find(prefix string, suffix string, versions []string) (found string, err error) {
-	var parsedVersions blangsemver.Versions
-	var parsedVersion blangsemver.Version
-
-	var validVersion = regexp.MustCompile(`^\d+\.\d+\.\d+$`) //to allow simple version like 0.23.234
-	var filteredVersions []string
-	for _, version := range versions {
-		if prefix == "v" && validVersion.MatchString(version) || strings.Contains(version, prefix) && strings.Contains(version, suffix) {
-			filteredVersions = append(filteredVersions, version)
-		}
-	}
-
-	for _, version := range filteredVersions {
-		if parsedVersion, err = Parse(prefix, suffix, version); err != nil {
-			continue
-		}
-
-		parsedVersions = append(parsedVersions, parsedVersion)
-	}
+// Find finds the tag of the biggest valid semver version in a slice of tags.
+// Only tags matching the template are considered. The initial order of the tags does not matter.
+// Returns the tag of the biggest valid semver version if found, otherwise an error stating no valid semver version has been found.
+func Find(tmpl Template, tags []string) (found string, err error) {
+	var all = FindAll(tmpl, tags)
 
-	if len(parsedVersions) == 0 {
+	if len(all) == 0 {
 		return found, fmt.Errorf("could not find a valid semver version")
 	}
 
-	blangsemver.Sort(parsedVersions)
+	return all[0], nil
+}
+
+// FindAll finds the tags of all valid semver versions in a slice of tags.
+// Only tags matching the template are considered. Tags with an equal version keep their initial order.
+// Returns the tags sorted by version in descending order.
+func FindAll(tmpl Template, tags []string) (found []string) {
+	var versions = map[string]blangsemver.Version{}
 
-	var targetVersion = parsedVersions[len(parsedVersions)-1]
+	for _, tag := range tags {
+		var parsed, err = tmpl.Parse(tag)
 
-	// necessary because blangsemver's Version.String() strips any prefix
-	for _, version := range filteredVersions {
-		if strings.Contains(version, targetVersion.String()) {
-			found = version
-			break
+		if err != nil {
+			continue
 		}
+
+		versions[tag] = parsed
+		found = append(found, tag)
 	}
 
-	return found, nil
+	sort.SliceStable(found, func(i, j int) bool { return versions[found[i]].GT(versions[found[j]]) })
+
+	return found
 }
@@ -22,6 +22,9 @@ func TestFind(t *testing.T) {
 		{Name: "SkipVersionIfInvalid", Prefix: "v", Suffix: "", Versions: []string{"invalid1", "invalid2", "v0.1.0"}, WantIndex: 2},
 		{Name: "FindVersionWhenDifferentOrder", Prefix: "v", Suffix: "", Versions: []string{"v1.3.1", "v0.2.0", "v2.3.0"}, WantIndex: 2},
 		{Name: "FindVersionWhenMultiplePrefixes", Prefix: "v", Suffix: "", Versions: []string{"v1.3.1", "v0.2.0", "2.3.0"}, WantIndex: 2},
+		{Name: "SkipVersionOnlyContainingPrefix", Prefix: "v", Suffix: "", Versions: []string{"prev2.0.0", "v0.2.0", "v1.0.0-dev"}, WantIndex: 2},
+		{Name: "FindVersionWithPrefixInVersion", Prefix: "0", Suffix: "", Versions: []string{"01.0.0", "00.2.0", "1.3.0"}, WantIndex: 0},
+		{Name: "FindVersionWithSuffixInVersion", Prefix: "v", Suffix: "1", Versions: []string{"v1.0.1", "v1.0.01", "v1.0.11"}, WantIndex: 2},
 		{Name: "FindVersionWhenMultipleSuffixes", Prefix: "v", Suffix: "n", Versions: []string{"v1.3.1a", "v0.2.0-alt", "v2.3.0n"}, WantIndex: 2},
 		{Name: "FindVersionWhenMultiplePrefixesWithSameVersion", Prefix: "c", Suffix: "", Versions: []string{"v1.3.1", "v0.2.0", "c1.3.1"}, WantIndex: 2},
 		{Name: "FindVersionWhenMultiplePrefixesWithSameVersion", Prefix: "c", Suffix: "", Versions: []string{"v1.3.1", "1.4.0", "c1.3.1"}, WantIndex: 2},
@@ -32,7 +35,7 @@ func TestFind(t *testing.T) {
 		t.Run(test.Name, func(t *testing.T) {
 			var versions = test.Versions
 			var want = versions[test.WantIndex]
-			var got, err = Find(test.Prefix, test.Suffix, versions)
+			var got, err = Find(newTemplate(t, test.Prefix, test.Suffix), versions)
 
 			assert.Equal(t, want, got, `want: "%s", got: "%s"`, want, got)
 			assert.NoError(t, err)
@@ -51,8 +54,33 @@ func TestFind(t *testing.T) {
 
 	for _, test := range errorTests {
 		t.Run(test.Name, func(t *testing.T) {
-			var _, got = Find("v", "a", test.Versions)
+			var _, got = Find(newTemplate(t, "v", "a"), test.Versions)
 			assert.Error(t, got)
 		})
 	}
 }
+
+func TestFindAll(t *testing.T) {
+	type Test struct {
+		Name   string
+		Prefix string
+		Suffix string
+		Tags   []string
+		Want   []string
+	}
+
+	var tests = []Test{
+		{Name: "SortVersionsDescending", Prefix: "v", Suffix: "", Tags: []string{"v0.2.0", "v1.0.0", "v0.10.0"}, Want: []string{"v1.0.0", "v0.10.0", "v0.2.0"}},
+		{Name: "SortPrereleasesBeforeRelease", Prefix: "v", Suffix: "", Tags: []string{"v1.0.0-rc.2", "v1.0.0", "v1.0.0-rc.10"}, Want: []string{"v1.0.0", "v1.0.0-rc.10", "v1.0.0-rc.2"}},
+		{Name: "SkipTagsNotMatchingTemplate", Prefix: "v", Suffix: "", Tags: []string{"c1.0.0", "v0.1.0", "invalid"}, Want: []string{"v0.1.0"}},
+		{Name: "KeepOrderOfEqualVersions", Prefix: "v", Suffix: "", Tags: []string{"v1.0.0+b", "v1.0.0+a"}, Want: []string{"v1.0.0+b", "v1.0.0+a"}},
+		{Name: "ReturnNothingWithoutVersions", Prefix: "v", Suffix: "", Tags: []string{"invalid"}, Want: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got = FindAll(newTemplate(t, test.Prefix, test.Suffix), test.Tags)
+			assert.Equal(t, test.Want, got, `want: "%s", got: "%s"`, test.Want, got)
+		})
+	}
+}
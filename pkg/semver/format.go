@@ -0,0 +1,82 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	blangsemver "github.com/blang/semver/v4"
+)
+
+// shortCommitLength the length of an abbreviated commit hash, like git's default.
+const shortCommitLength = 7
+
+// VersionFields the fields of a version which are available to output templates, e.g. {{.Major}}.{{.Minor}}.
+// The prerelease and build fields are the dot-separated identifiers without the leading - or +.
+type VersionFields struct {
+	Major       uint64
+	Minor       uint64
+	Patch       uint64
+	Prerelease  string
+	Build       string
+	Version     string
+	Prefix      string
+	Suffix      string
+	Tag         string
+	Commit      string
+	ShortCommit string
+}
+
+// NewVersionFields creates new VersionFields for a version, its tag and the commit it points to.
+// Returns the new VersionFields or an error if the version is not a valid semver version.
+func NewVersionFields(version string, tag string, commit string, prefix string, suffix string) (fields VersionFields, err error) {
+	var parsed blangsemver.Version
+
+	if parsed, err = blangsemver.Parse(version); err != nil {
+		return fields, err
+	}
+
+	var prerelease = make([]string, len(parsed.Pre))
+
+	for i, identifier := range parsed.Pre {
+		prerelease[i] = identifier.String()
+	}
+
+	fields = VersionFields{
+		Major:       parsed.Major,
+		Minor:       parsed.Minor,
+		Patch:       parsed.Patch,
+		Prerelease:  strings.Join(prerelease, "."),
+		Build:       strings.Join(parsed.Build, "."),
+		Version:     version,
+		Prefix:      prefix,
+		Suffix:      suffix,
+		Tag:         tag,
+		Commit:      commit,
+		ShortCommit: commit,
+	}
+
+	if len(commit) > shortCommitLength {
+		fields.ShortCommit = commit[:shortCommitLength]
+	}
+
+	return fields, nil
+}
+
+// Format renders version fields with an output template, e.g. {{.Major}}.{{.Minor}} for a Docker tag.
+// Returns the rendered text or an error if the template is invalid or uses an unknown field.
+func Format(text string, fields VersionFields) (formatted string, err error) {
+	var tmpl *template.Template
+
+	if tmpl, err = template.New("output").Option("missingkey=error").Parse(text); err != nil {
+		return formatted, fmt.Errorf("invalid output template '%s': %w", text, err)
+	}
+
+	var builder strings.Builder
+
+	if err = tmpl.Execute(&builder, fields); err != nil {
+		return formatted, fmt.Errorf("invalid output template '%s': %w", text, err)
+	}
+
+	return builder.String(), nil
+}
@@ -0,0 +1,79 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVersionFields(t *testing.T) {
+	t.Run("SplitVersion", func(t *testing.T) {
+		var want = VersionFields{
+			Major:       1,
+			Minor:       2,
+			Patch:       3,
+			Prerelease:  "rc.1",
+			Build:       "build.5",
+			Version:     "1.2.3-rc.1+build.5",
+			Prefix:      "v",
+			Tag:         "v1.2.3-rc.1+build.5",
+			Commit:      "c891781912fc22595e98a8a2a5d4dc580baf7d73",
+			ShortCommit: "c891781",
+		}
+
+		var got, err = NewVersionFields(want.Version, want.Tag, want.Commit, "v", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%v, got: "%v"`, want, got)
+	})
+
+	t.Run("KeepShortCommit", func(t *testing.T) {
+		var got, err = NewVersionFields("1.2.3", "", "c89", "", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "c89", got.ShortCommit)
+	})
+
+	t.Run("ReturnErrorOnInvalidVersion", func(t *testing.T) {
+		var _, err = NewVersionFields("invalid", "", "", "", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestFormat(t *testing.T) {
+	var fields, err = NewVersionFields("1.2.3-rc.1", "app-v1.2.3-rc.1", "c891781912fc22595e98a8a2a5d4dc580baf7d73", "v", "")
+	require.NoError(t, err)
+
+	type Test struct {
+		Name string
+		Text string
+		Want string
+	}
+
+	var tests = []Test{
+		{Name: "RenderMajorMinor", Text: "{{.Major}}.{{.Minor}}", Want: "1.2"},
+		{Name: "RenderPrerelease", Text: "{{.Major}}.{{.Minor}}.{{.Patch}}-{{.Prerelease}}", Want: "1.2.3-rc.1"},
+		{Name: "RenderTagAndShortCommit", Text: "{{.Tag}}-{{.ShortCommit}}", Want: "app-v1.2.3-rc.1-c891781"},
+		{Name: "RenderConditionals", Text: "{{.Prefix}}{{.Version}}{{if .Build}}+{{.Build}}{{end}}", Want: "v1.2.3-rc.1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got, err = Format(test.Text, fields)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidSyntax", func(t *testing.T) {
+		var _, err = Format("{{.Major", fields)
+		assert.Error(t, err)
+	})
+
+	t.Run("ReturnErrorOnUnknownField", func(t *testing.T) {
+		var _, err = Format("{{.Branch}}", fields)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// versionCorePattern matches the major.minor.patch part of a version in a tag.
+var versionCorePattern = regexp.MustCompile(`\d+\.\d+\.\d+`)
+
+// InferAffixes infers the prefix and suffix of a slice of tags, e.g. v and -api for v1.2.0-api.
+// The prefix and suffix of each tag are the parts before and after its major.minor.patch version.
+// The most common combination wins, so prerelease versions like v1.2.0-rc.1 do not count as a suffix
+// unless most tags share them. Ties are broken in favor of the shortest suffix, then the shortest prefix.
+// Returns the prefix and suffix or an error if no tag contains a version.
+func InferAffixes(tags []string) (prefix string, suffix string, err error) {
+	type affixes struct {
+		prefix string
+		suffix string
+	}
+
+	var counts = map[affixes]int{}
+
+	for _, tag := range tags {
+		var location = versionCorePattern.FindStringIndex(tag)
+
+		if location == nil {
+			continue
+		}
+
+		counts[affixes{prefix: tag[:location[0]], suffix: tag[location[1]:]}]++
+	}
+
+	if len(counts) == 0 {
+		return prefix, suffix, fmt.Errorf("could not find a tag with a semver version")
+	}
+
+	var candidates []affixes
+
+	for candidate := range counts {
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		var a, b = candidates[i], candidates[j]
+
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+
+		if len(a.suffix) != len(b.suffix) {
+			return len(a.suffix) < len(b.suffix)
+		}
+
+		if len(a.prefix) != len(b.prefix) {
+			return len(a.prefix) < len(b.prefix)
+		}
+
+		return a.prefix+a.suffix < b.prefix+b.suffix
+	})
+
+	return candidates[0].prefix, candidates[0].suffix, err
+}
@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferAffixes(t *testing.T) {
+	type Test struct {
+		Name       string
+		Tags       []string
+		WantPrefix string
+		WantSuffix string
+	}
+
+	var tests = []Test{
+		{Name: "InferPrefix", Tags: []string{"v1.0.0", "v1.1.0", "v2.0.0"}, WantPrefix: "v", WantSuffix: ""},
+		{Name: "InferNoAffixes", Tags: []string{"1.0.0", "1.1.0"}, WantPrefix: "", WantSuffix: ""},
+		{Name: "InferPrefixAndSuffix", Tags: []string{"api-v1.0.0-api", "api-v1.1.0-api"}, WantPrefix: "api-v", WantSuffix: "-api"},
+		{Name: "IgnorePrereleases", Tags: []string{"v1.0.0", "v1.1.0-rc.1", "v1.1.0-rc.2", "v1.1.0"}, WantPrefix: "v", WantSuffix: ""},
+		{Name: "IgnoreTagsWithoutVersion", Tags: []string{"latest", "stable", "release-1.0.0"}, WantPrefix: "release-", WantSuffix: ""},
+		{Name: "InferMostCommonPrefix", Tags: []string{"1.0.0", "v1.1.0", "v1.2.0"}, WantPrefix: "v", WantSuffix: ""},
+		{Name: "PreferShortestAffixesOnTie", Tags: []string{"version-1.0.0", "v1.1.0"}, WantPrefix: "v", WantSuffix: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var prefix, suffix, err = InferAffixes(test.Tags)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.WantPrefix, prefix, `want: "%s", got: "%s"`, test.WantPrefix, prefix)
+			assert.Equal(t, test.WantSuffix, suffix, `want: "%s", got: "%s"`, test.WantSuffix, suffix)
+		})
+	}
+
+	t.Run("ReturnErrorWithoutVersions", func(t *testing.T) {
+		var _, _, err = InferAffixes([]string{"latest"})
+		assert.Error(t, err)
+	})
+}
@@ -16,7 +16,6 @@ func TestParse(t *testing.T) {
 		Patch    string
 		Prebuild string
 		Prefix   string
-		Suffix   string
 	}
 
 	var tests = []Test{
@@ -24,18 +23,15 @@ func TestParse(t *testing.T) {
 		{Name: "Patch", Major: "0", Minor: "0", Patch: "1"},
 		{Name: "Minor", Major: "0", Minor: "2", Patch: "0"},
 		{Name: "Major", Major: "3", Minor: "0", Patch: "0"},
-		{Name: "DiscardPrefix", Major: "1", Minor: "0", Patch: "0", Prefix: "v"},
-		{Name: "DiscardSuffix", Major: "2", Minor: "0", Patch: "0", Suffix: "a"},
-		{Name: "DiscardSuffixAlt", Major: "2", Minor: "0", Patch: "0", Suffix: "-alt"},
-		{Name: "KeepPrebuild", Major: "2", Minor: "0", Patch: "0", Suffix: "", Prebuild: "-pre+001"},
+		{Name: "TolerateVPrefix", Major: "1", Minor: "0", Patch: "0", Prefix: "v"},
+		{Name: "KeepPrebuild", Major: "2", Minor: "0", Patch: "0", Prebuild: "-pre+001"},
 	}
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			var version = fmt.Sprintf(`%s%s.%s.%s%s%s`, test.Prefix, test.Major, test.Minor, test.Patch,
-				test.Suffix, test.Prebuild)
+			var version = fmt.Sprintf(`%s%s.%s.%s%s`, test.Prefix, test.Major, test.Minor, test.Patch, test.Prebuild)
 
-			var got, err = Parse(test.Prefix, test.Suffix, version)
+			var got, err = Parse(version)
 
 			assert.Equal(t, test.Major, fmt.Sprint(got.Major), `want: "%s", got: "%d"`, test.Major, got.Major)
 			assert.Equal(t, test.Minor, fmt.Sprint(got.Minor), `want: "%s", got: "%s"`, test.Minor, got.Minor)
@@ -45,10 +41,6 @@ func TestParse(t *testing.T) {
 				assert.False(t, strings.HasPrefix(got.String(), test.Prefix))
 			}
 
-			if test.Suffix != "" {
-				assert.False(t, strings.HasSuffix(got.String(), test.Suffix))
-			}
-
 			if test.Prebuild != "" {
 				assert.True(t, strings.HasSuffix(got.String(), test.Prebuild))
 			}
@@ -56,4 +48,9 @@ func TestParse(t *testing.T) {
 			assert.NoError(t, err)
 		})
 	}
+
+	t.Run("ReturnErrorOnInvalidVersion", func(t *testing.T) {
+		var _, err = Parse("invalid")
+		assert.Error(t, err)
+	})
 }
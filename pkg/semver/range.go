@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	blangsemver "github.com/blang/semver/v4"
+)
+
+// partialVersion matches a comparator with a version missing its minor or patch level, e.g. ">=1.2" or "<2".
+var partialVersion = regexp.MustCompile(`^([<>=!]*)(\d+(?:\.\d+)?)$`)
+
+// npmVersion matches a caret or tilde comparator like npm supports, e.g. "^1.4", "~1.2.3" or "^0.x".
+var npmVersion = regexp.MustCompile(`^([\^~])(\d+)(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?(-[0-9A-Za-z.-]+)?$`)
+
+// ParseRange parses a range of versions, e.g. ">=1.0.0 <2.0.0", ">=1.2 <2", "1.4.x", "^1.4" or "~1.2.3".
+// Partial versions are treated as wildcards, e.g. "1.2" equals "1.2.x".
+// Caret ranges allow changes which do not modify the left-most non-zero level, e.g. "^1.4" equals ">=1.4.0 <2.0.0".
+// Tilde ranges allow patch level changes, or minor level changes if no minor level is given, e.g. "~1.2" equals ">=1.2.0 <1.3.0".
+// See the library documentation for the remaining range syntax.
+// Returns the parsed blang/semver/v4 Range or an error if the range is invalid.
+func ParseRange(value string) (allowed blangsemver.Range, err error) {
+	var parts = strings.Fields(value)
+
+	for i, part := range parts {
+		if matches := npmVersion.FindStringSubmatch(part); matches != nil {
+			parts[i] = expandNPMVersion(matches[1], matches[2], matches[3], matches[4], matches[5])
+			continue
+		}
+
+		parts[i] = partialVersion.ReplaceAllString(part, "${1}${2}.x")
+	}
+
+	if allowed, err = blangsemver.ParseRange(strings.Join(parts, " ")); err != nil {
+		return allowed, fmt.Errorf("invalid version range '%s': %w", value, err)
+	}
+
+	return allowed, nil
+}
+
+// Satisfies checks whether a version satisfies a range of versions, see ParseRange for the supported syntax.
+// Returns true if the version is in range or an error if the version or the range is invalid.
+func Satisfies(version string, value string) (satisfied bool, err error) {
+	var allowed blangsemver.Range
+	var parsed blangsemver.Version
+
+	if allowed, err = ParseRange(value); err != nil {
+		return satisfied, err
+	}
+
+	if parsed, err = Parse(version); err != nil {
+		return satisfied, fmt.Errorf("invalid version '%s': %w", version, err)
+	}
+
+	return allowed(parsed), nil
+}
+
+// expandNPMVersion expands a caret or tilde comparator into a lower and upper bound, e.g. "^1.4" into ">=1.4.0 <2.0.0".
+// Missing or wildcard minor and patch levels are treated as 0 for the lower bound and widen the upper bound.
+// Returns the bounds separated by a space, which blang/semver/v4 combines with a logical AND.
+func expandNPMVersion(operator string, major string, minor string, patch string, prerelease string) string {
+	var isWildcard = func(level string) bool {
+		return level == "" || strings.ContainsAny(level, "xX*")
+	}
+
+	var number = func(level string) uint64 {
+		var parsed, _ = strconv.ParseUint(level, 10, 64)
+		return parsed
+	}
+
+	var x, y, z = number(major), number(minor), number(patch)
+	var lower = fmt.Sprintf("%d.%d.%d%s", x, y, z, prerelease)
+	var upper string
+
+	switch {
+	case isWildcard(minor) || (operator == "^" && x > 0):
+		upper = fmt.Sprintf("%d.0.0", x+1)
+	case operator == "~" || isWildcard(patch) || y > 0:
+		upper = fmt.Sprintf("%d.%d.0", x, y+1)
+	default:
+		upper = fmt.Sprintf("%d.%d.%d", x, y, z+1)
+	}
+
+	return fmt.Sprintf(">=%s <%s", lower, upper)
+}
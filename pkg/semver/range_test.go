@@ -0,0 +1,91 @@
+package semver
+
+import (
+	"testing"
+
+	blangsemver "github.com/blang/semver/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	type Test struct {
+		Name    string
+		Range   string
+		Version string
+		Want    bool
+	}
+
+	var tests = []Test{
+		{Name: "ContainVersionInBoundedRange", Range: ">=1.0.0 <2.0.0", Version: "1.5.0", Want: true},
+		{Name: "ExcludeVersionOutsideBoundedRange", Range: ">=1.0.0 <2.0.0", Version: "2.0.0", Want: false},
+		{Name: "ContainVersionInMinorWildcard", Range: "1.4.x", Version: "1.4.3", Want: true},
+		{Name: "ExcludeVersionOutsideMinorWildcard", Range: "1.4.x", Version: "1.5.0", Want: false},
+		{Name: "ContainVersionInMajorWildcard", Range: "1.x", Version: "1.9.0", Want: true},
+		{Name: "ContainVersionInPartialRange", Range: ">=1.2 <2", Version: "1.9.0", Want: true},
+		{Name: "ExcludeVersionBelowPartialRange", Range: ">=1.2 <2", Version: "1.1.9", Want: false},
+		{Name: "ExcludeVersionAbovePartialRange", Range: ">=1.2 <2", Version: "2.0.0", Want: false},
+		{Name: "ContainVersionInCaretRange", Range: "^1.4", Version: "1.9.0", Want: true},
+		{Name: "ExcludeVersionBelowCaretRange", Range: "^1.4", Version: "1.3.9", Want: false},
+		{Name: "ExcludeNextMajorFromCaretRange", Range: "^1.4.2", Version: "2.0.0", Want: false},
+		{Name: "ExcludeNextMinorFromZeroMajorCaretRange", Range: "^0.2.3", Version: "0.3.0", Want: false},
+		{Name: "ContainPatchInZeroMajorCaretRange", Range: "^0.2.3", Version: "0.2.9", Want: true},
+		{Name: "ExcludeNextPatchFromZeroMinorCaretRange", Range: "^0.0.3", Version: "0.0.4", Want: false},
+		{Name: "ContainMinorInZeroMinorWildcardCaretRange", Range: "^0.0", Version: "0.0.9", Want: true},
+		{Name: "ContainMinorInMajorWildcardCaretRange", Range: "^1.x", Version: "1.9.0", Want: true},
+		{Name: "ContainPrereleaseInCaretRange", Range: "^1.0.0-rc.1", Version: "1.0.0-rc.2", Want: true},
+		{Name: "ContainPatchInTildeRange", Range: "~1.2.3", Version: "1.2.9", Want: true},
+		{Name: "ExcludeNextMinorFromTildeRange", Range: "~1.2.3", Version: "1.3.0", Want: false},
+		{Name: "ExcludeVersionBelowTildeRange", Range: "~1.2.3", Version: "1.2.2", Want: false},
+		{Name: "ContainMinorInMajorTildeRange", Range: "~1", Version: "1.9.0", Want: true},
+		{Name: "CombineCaretRanges", Range: "^1.4 || ^3", Version: "3.1.0", Want: true},
+		{Name: "TrimWhitespace", Range: " 1.x ", Version: "1.0.0", Want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var allowed, err = ParseRange(test.Range)
+			assert.NoError(t, err)
+
+			var got = allowed(blangsemver.MustParse(test.Version))
+			assert.Equal(t, test.Want, got, `want: "%t", got: "%t"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidRange", func(t *testing.T) {
+		var _, err = ParseRange("invalid")
+		assert.Error(t, err)
+	})
+}
+
+func TestSatisfies(t *testing.T) {
+	type Test struct {
+		Name    string
+		Range   string
+		Version string
+		Want    bool
+	}
+
+	var tests = []Test{
+		{Name: "SatisfyRange", Range: "^1.4", Version: "1.4.0", Want: true},
+		{Name: "SatisfyRangeWithPrefixedVersion", Range: "^1.4", Version: "v1.5.0", Want: true},
+		{Name: "NotSatisfyRange", Range: "^1.4", Version: "2.0.0", Want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got, err = Satisfies(test.Version, test.Range)
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%t", got: "%t"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidRange", func(t *testing.T) {
+		var _, err = Satisfies("1.0.0", "invalid")
+		assert.Error(t, err)
+	})
+
+	t.Run("ReturnErrorOnInvalidVersion", func(t *testing.T) {
+		var _, err = Satisfies("invalid", "^1.4")
+		assert.Error(t, err)
+	})
+}
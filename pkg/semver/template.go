@@ -0,0 +1,168 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template/parse"
+
+	blangsemver "github.com/blang/semver/v4"
+)
+
+// DefaultTemplate the default template to render versions into tags, e.g. v1.0.0 for prefix "v".
+const DefaultTemplate = "{{.Prefix}}{{.Version}}{{.Suffix}}"
+
+// pattern matches a strict semver version, without leading zeros, which makes parsing tags unambiguous.
+const pattern = `(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?`
+
+// bareVersionPattern matches a version without prefix or suffix, which the default template accepts for the "v" prefix.
+var bareVersionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// Template renders versions into tags and parses tags back into versions.
+// The text supports the {{.Prefix}}, {{.Project}}, {{.Suffix}} and {{.Version}} fields, where {{.Version}} is required once.
+// Rendering and parsing of custom templates are exact inverses, a tag only parses if it renders from the parsed version.
+// The default template is tolerant as well, see Template.Parse, so a tag it parses does not necessarily render from its version.
+type Template struct {
+	Prefix   string
+	Project  string
+	Suffix   string
+	Text     string
+	parts    []string
+	regexp   *regexp.Regexp
+	tolerant bool
+}
+
+// NewTemplate creates a new Template.
+// Returns the new Template or an error if the text is not a valid template.
+func NewTemplate(text string, prefix string, suffix string, project string) (tmpl Template, err error) {
+	var trees map[string]*parse.Tree
+
+	if trees, err = parse.Parse("tag", text, "", "", map[string]any{}); err != nil {
+		return tmpl, fmt.Errorf("invalid tag template '%s': %w", text, err)
+	}
+
+	tmpl = Template{Prefix: prefix, Project: project, Suffix: suffix, Text: text, tolerant: text == DefaultTemplate}
+
+	var fields = map[string]string{"Prefix": prefix, "Project": project, "Suffix": suffix}
+	var expression strings.Builder
+	var versions int
+
+	expression.WriteString("^")
+
+	for _, node := range trees["tag"].Root.Nodes {
+		var part string
+
+		switch node := node.(type) {
+		case *parse.TextNode:
+			part = string(node.Text)
+			expression.WriteString(regexp.QuoteMeta(part))
+		case *parse.ActionNode:
+			var field = getField(node)
+
+			if field == "Version" {
+				versions++
+				expression.WriteString("(" + pattern + ")")
+				tmpl.parts = append(tmpl.parts, "")
+				continue
+			}
+
+			var value, known = fields[field]
+
+			if !known {
+				return Template{}, fmt.Errorf("invalid tag template '%s': unsupported action %s", text, node)
+			}
+
+			part = value
+			expression.WriteString(regexp.QuoteMeta(part))
+		default:
+			return Template{}, fmt.Errorf("invalid tag template '%s': unsupported action %s", text, node)
+		}
+
+		// consecutive literals are merged, versions are marked by an empty part
+		if count := len(tmpl.parts); count > 0 && tmpl.parts[count-1] != "" {
+			tmpl.parts[count-1] += part
+		} else if part != "" {
+			tmpl.parts = append(tmpl.parts, part)
+		}
+	}
+
+	if versions != 1 {
+		return Template{}, fmt.Errorf("invalid tag template '%s': {{.Version}} is required exactly once", text)
+	}
+
+	expression.WriteString("$")
+
+	tmpl.regexp = regexp.MustCompile(expression.String())
+
+	return tmpl, nil
+}
+
+// Render renders a version into a tag.
+// Returns the tag.
+func (tmpl Template) Render(version string) string {
+	var builder strings.Builder
+
+	for _, part := range tmpl.parts {
+		if part == "" {
+			part = version
+		}
+
+		builder.WriteString(part)
+	}
+
+	return builder.String()
+}
+
+// Parse parses a tag into a semver version struct.
+// The default template falls back to parsing tags like before tag templates existed: a tag between the prefix and suffix
+// is parsed tolerantly, e.g. v1.0 as 1.0.0, and a bare version like 1.2.3 is accepted for the "v" prefix.
+// Returns the parsed blang/semver/v4 Version or an error if the tag does not match the template.
+func (tmpl Template) Parse(tag string) (version blangsemver.Version, err error) {
+	if tmpl.regexp == nil {
+		return version, fmt.Errorf("tag template is not initialized")
+	}
+
+	var matches = tmpl.regexp.FindStringSubmatch(tag)
+
+	if matches != nil {
+		return blangsemver.Parse(matches[1])
+	}
+
+	if tmpl.tolerant {
+		if version, err = tmpl.parseTolerant(tag); err == nil {
+			return version, err
+		}
+	}
+
+	return version, fmt.Errorf("tag '%s' does not match template '%s'", tag, tmpl.Text)
+}
+
+// parseTolerant parses a tag which does not strictly match the default template.
+// Returns the parsed blang/semver/v4 Version or an error if the tag is not a version between the prefix and suffix.
+func (tmpl Template) parseTolerant(tag string) (version blangsemver.Version, err error) {
+	if tmpl.Prefix == "v" && bareVersionPattern.MatchString(tag) {
+		return blangsemver.ParseTolerant(tag)
+	}
+
+	if len(tag) < len(tmpl.Prefix)+len(tmpl.Suffix) || !strings.HasPrefix(tag, tmpl.Prefix) || !strings.HasSuffix(tag, tmpl.Suffix) {
+		return version, fmt.Errorf("tag '%s' does not have prefix '%s' and suffix '%s'", tag, tmpl.Prefix, tmpl.Suffix)
+	}
+
+	return blangsemver.ParseTolerant(tag[len(tmpl.Prefix) : len(tag)-len(tmpl.Suffix)])
+}
+
+// getField gets the name of the field an action node prints, e.g. Version for {{.Version}}.
+// Returns the field name or an empty string if the action does more than printing a single field.
+func getField(node *parse.ActionNode) string {
+	if len(node.Pipe.Decl) != 0 || len(node.Pipe.Cmds) != 1 || len(node.Pipe.Cmds[0].Args) != 1 {
+		return ""
+	}
+
+	var field, isField = node.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+
+	if !isField || len(field.Ident) != 1 {
+		return ""
+	}
+
+	return field.Ident[0]
+}
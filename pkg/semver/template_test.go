@@ -0,0 +1,165 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTemplate creates the default tag template with a prefix and suffix.
+func newTemplate(t *testing.T, prefix string, suffix string) Template {
+	var template, err = NewTemplate(DefaultTemplate, prefix, suffix, "")
+	require.NoError(t, err)
+	return template
+}
+
+func TestNewTemplate(t *testing.T) {
+	type ErrorTest struct {
+		Name string
+		Text string
+	}
+
+	var errorTests = []ErrorTest{
+		{Name: "ReturnErrorOnInvalidSyntax", Text: "{{.Version"},
+		{Name: "ReturnErrorOnMissingVersion", Text: "{{.Prefix}}"},
+		{Name: "ReturnErrorOnRepeatedVersion", Text: "{{.Version}}-{{.Version}}"},
+		{Name: "ReturnErrorOnUnknownField", Text: "{{.Branch}}{{.Version}}"},
+		{Name: "ReturnErrorOnUnsupportedAction", Text: "{{if .Prefix}}v{{end}}{{.Version}}"},
+		{Name: "ReturnErrorOnFunction", Text: "{{lower .Project}}{{.Version}}"},
+	}
+
+	for _, test := range errorTests {
+		t.Run(test.Name, func(t *testing.T) {
+			var _, err = NewTemplate(test.Text, "v", "", "")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	type Test struct {
+		Name    string
+		Prefix  string
+		Project string
+		Suffix  string
+		Text    string
+		Want    string
+	}
+
+	var tests = []Test{
+		{Name: "RenderDefaultTemplate", Text: DefaultTemplate, Prefix: "v", Want: "v1.0.0"},
+		{Name: "RenderWithSuffix", Text: DefaultTemplate, Prefix: "v", Suffix: "-alt", Want: "v1.0.0-alt"},
+		{Name: "RenderWithoutPrefix", Text: DefaultTemplate, Want: "1.0.0"},
+		{Name: "RenderProject", Text: "{{.Project}}-v{{.Version}}{{.Suffix}}", Project: "api", Suffix: "a", Want: "api-v1.0.0a"},
+		{Name: "RenderLiterals", Text: "release/{{ .Version }}/final", Want: "release/1.0.0/final"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var template, err = NewTemplate(test.Text, test.Prefix, test.Suffix, test.Project)
+			require.NoError(t, err)
+
+			var got = template.Render("1.0.0")
+			assert.Equal(t, test.Want, got, `want: "%s", got: "%s"`, test.Want, got)
+		})
+	}
+}
+
+func TestTemplate_Parse(t *testing.T) {
+	type Test struct {
+		Name    string
+		Prefix  string
+		Project string
+		Suffix  string
+		Tag     string
+		Text    string
+		Want    string
+	}
+
+	var tests = []Test{
+		{Name: "ParseDefaultTemplate", Text: DefaultTemplate, Prefix: "v", Tag: "v1.2.3", Want: "1.2.3"},
+		{Name: "ParsePrerelease", Text: DefaultTemplate, Prefix: "v", Tag: "v1.2.3-rc.1", Want: "1.2.3-rc.1"},
+		{Name: "ParsePrereleaseBeforeSuffix", Text: DefaultTemplate, Prefix: "v", Suffix: "-alt", Tag: "v1.2.3-rc.1-alt", Want: "1.2.3-rc.1"},
+		{Name: "ParsePrefixInVersion", Text: DefaultTemplate, Prefix: "0", Tag: "00.1.0", Want: "0.1.0"},
+		{Name: "ParseSuffixInVersion", Text: DefaultTemplate, Prefix: "v", Suffix: "1", Tag: "v1.0.11", Want: "1.0.1"},
+		{Name: "ParseProject", Text: "{{.Project}}-v{{.Version}}", Project: "api", Tag: "api-v2.0.0", Want: "2.0.0"},
+		{Name: "ParseSpecialCharacters", Text: "{{.Project}}@{{.Version}}", Project: "@scope/pkg.js", Tag: "@scope/pkg.js@1.0.0", Want: "1.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var template, err = NewTemplate(test.Text, test.Prefix, test.Suffix, test.Project)
+			require.NoError(t, err)
+
+			var got, parseErr = template.Parse(test.Tag)
+			assert.NoError(t, parseErr)
+			assert.Equal(t, test.Want, got.String(), `want: "%s", got: "%s"`, test.Want, got)
+
+			var rendered = template.Render(got.String())
+			assert.Equal(t, test.Tag, rendered, `want: "%s", got: "%s"`, test.Tag, rendered)
+		})
+	}
+
+	type ErrorTest struct {
+		Name string
+		Tag  string
+		Text string
+	}
+
+	var errorTests = []ErrorTest{
+		{Name: "ReturnErrorOnMissingPrefix", Text: "{{.Prefix}}{{.Version}}", Tag: "1.0.0"},
+		{Name: "ReturnErrorOnTextAroundTag", Text: DefaultTemplate, Tag: "prev1.0.0"},
+		{Name: "ReturnErrorOnOtherProject", Text: "{{.Project}}-{{.Prefix}}{{.Version}}", Tag: "web-v1.0.0"},
+		{Name: "ReturnErrorOnLeadingZeros", Text: "{{.Prefix}}{{.Version}}", Tag: "v01.0.0"},
+		{Name: "ReturnErrorOnIncompleteVersion", Text: "{{.Prefix}}{{.Version}}", Tag: "v1.0"},
+	}
+
+	for _, test := range errorTests {
+		t.Run(test.Name, func(t *testing.T) {
+			var template, err = NewTemplate(test.Text, "v", "", "api")
+			require.NoError(t, err)
+
+			_, err = template.Parse(test.Tag)
+			assert.Error(t, err)
+		})
+	}
+
+	type TolerantTest struct {
+		Name     string
+		Prefix   string
+		Rendered string
+		Suffix   string
+		Tag      string
+		Want     string
+	}
+
+	// tolerantly parsed tags are not the inverse of rendering, the rendered tag is the strict form of the version
+	var tolerantTests = []TolerantTest{
+		{Name: "ParseBareVersionWithVPrefix", Prefix: "v", Tag: "1.2.3", Want: "1.2.3", Rendered: "v1.2.3"},
+		{Name: "ParseIncompleteVersion", Prefix: "v", Tag: "v1.0", Want: "1.0.0", Rendered: "v1.0.0"},
+		{Name: "ParseIncompleteVersionWithSuffix", Prefix: "v", Suffix: "-alt", Tag: "v1.0-alt", Want: "1.0.0", Rendered: "v1.0.0-alt"},
+	}
+
+	for _, test := range tolerantTests {
+		t.Run(test.Name, func(t *testing.T) {
+			var template = newTemplate(t, test.Prefix, test.Suffix)
+			var got, err = template.Parse(test.Tag)
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got.String(), `want: "%s", got: "%s"`, test.Want, got)
+
+			var rendered = template.Render(got.String())
+			assert.Equal(t, test.Rendered, rendered, `want: "%s", got: "%s"`, test.Rendered, rendered)
+		})
+	}
+
+	t.Run("ReturnErrorOnBareVersionWithOtherPrefix", func(t *testing.T) {
+		var _, err = newTemplate(t, "c", "").Parse("1.2.3")
+		assert.Error(t, err)
+	})
+
+	t.Run("ReturnErrorIfNotInitialized", func(t *testing.T) {
+		var _, err = Template{}.Parse("v1.0.0")
+		assert.Error(t, err)
+	})
+}
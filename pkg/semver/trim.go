@@ -1,22 +1,17 @@
 package semver
 
 import (
-	"strings"
-
 	blangsemver "github.com/blang/semver/v4"
 )
 
-// Trim trims a semver version string of anything but major.minor.patch information.
-// Returns the trimmed semver version.
-func Trim(prefix string, suffix string, version string) (string, error) {
+// Trim trims a tag of anything but major.minor.patch information.
+// Returns the trimmed semver version or an error if the tag does not match the template.
+func Trim(tmpl Template, tag string) (string, error) {
 	var semverVersion blangsemver.Version
 	var err error
 
-	var versionWithoutPrefix = strings.Replace(version, prefix, prefix, 1)
-	var versionWithoutPrefixOrSuffix = strings.Replace(versionWithoutPrefix, suffix, suffix, 1)
-
-	if semverVersion, err = Parse(prefix, suffix, versionWithoutPrefixOrSuffix); err != nil {
-		return version, err
+	if semverVersion, err = tmpl.Parse(tag); err != nil {
+		return tag, err
 	}
 
 	return semverVersion.FinalizeVersion(), err
@@ -39,7 +39,7 @@ func TestTrim(t *testing.T) {
 			want = strings.ReplaceAll(want, test.Suffix, "")
 			want = strings.ReplaceAll(want, test.Prebuild, "")
 
-			var got, err = Trim(test.Prefix, test.Suffix, version)
+			var got, err = Trim(newTemplate(t, test.Prefix, test.Suffix), version)
 
 			assert.Equal(t, want, got, `want: "%s", got: "%s"`, want, got)
 
@@ -66,11 +66,12 @@ func TestTrim(t *testing.T) {
 
 	var errorTests = []ErrorTest{
 		{Name: "ReturnErrorOnInvalidVersion", Version: "invalid"},
+		{Name: "ReturnErrorOnMissingSuffix", Version: "v1.0.0"},
 	}
 
 	for _, test := range errorTests {
 		t.Run(test.Name, func(t *testing.T) {
-			var _, got = Trim("v", "a", test.Version)
+			var _, got = Trim(newTemplate(t, "v", "a"), test.Version)
 			assert.Error(t, got)
 		})
 	}
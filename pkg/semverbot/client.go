@@ -0,0 +1,177 @@
+package semverbot
+
+import (
+	"context"
+
+	"github.com/restechnica/semverbot/internal"
+	"github.com/restechnica/semverbot/pkg/core"
+	"github.com/restechnica/semverbot/pkg/git"
+	"github.com/restechnica/semverbot/pkg/semver"
+	"github.com/restechnica/semverbot/pkg/versions"
+)
+
+// Result the result of working with a version, e.g. the current, predicted, released or pushed version.
+type Result = core.VersionResult
+
+// Config the configuration of a Client, the equivalent of the sbot configuration file and flags.
+// The fields correspond to the configuration properties, e.g. GitTagsPrefix to git.tags.prefix.
+type Config struct {
+	Branch               string
+	Constraints          []versions.Constraint
+	DefaultVersion       string
+	DryRun               bool
+	GitBackend           string
+	GitBranchDelimiters  string
+	GitCommitDelimiters  string
+	GitPushRemotes       []string
+	GitPushRetries       int
+	GitRemote            string
+	GitTagsMerged        bool
+	GitTagsPrefix        string
+	GitTagsProject       string
+	GitTagsSign          bool
+	GitTagsSigningFormat string
+	GitTagsSigningKey    string
+	GitTagsSuffix        string
+	GitTagsTemplate      string
+	Mode                 string
+	Ref                  string
+	RepoPath             string
+	SemverMap            semver.Map
+}
+
+// DefaultConfig creates a new Config with the defaults sbot uses without a configuration file.
+// Returns the new Config.
+func DefaultConfig() Config {
+	return Config{
+		DefaultVersion:      internal.DefaultVersion,
+		GitBackend:          internal.DefaultGitBackend,
+		GitBranchDelimiters: internal.DefaultGitBranchDelimiters,
+		GitCommitDelimiters: internal.DefaultGitCommitDelimiters,
+		GitPushRetries:      internal.DefaultGitPushRetries,
+		GitRemote:           internal.DefaultGitRemote,
+		GitTagsPrefix:       internal.DefaultGitTagsPrefix,
+		GitTagsSuffix:       internal.DefaultGitTagsSuffix,
+		GitTagsTemplate:     internal.DefaultGitTagsTemplate,
+		Mode:                internal.DefaultMode,
+		Ref:                 internal.DefaultGitRef,
+		SemverMap:           semver.Map{},
+	}
+}
+
+// Client a client to work with versions like sbot does, without any global state.
+type Client struct {
+	Config Config
+	GitAPI git.API
+}
+
+// NewClient creates a new Client.
+// The git.API is used for all git operations, e.g. to inject a fake in tests.
+// A git.API for the backend and repository path of the config is used if it is nil.
+// Returns the new Client.
+func NewClient(config Config, gitAPI git.API) Client {
+	if gitAPI == nil {
+		gitAPI = git.NewAPI(config.GitBackend, config.RepoPath)
+	}
+
+	return Client{Config: config, GitAPI: gitAPI}
+}
+
+// Current gets the current version.
+// Returns the current version or the default version if there is none, or an error if the configuration is invalid.
+func (client Client) Current(ctx context.Context) (result Result, err error) {
+	return core.GetVersion(ctx, &core.GetVersionOptions{
+		DefaultVersion:  client.Config.DefaultVersion,
+		GitAPI:          client.GitAPI,
+		GitTagPrefix:    client.Config.GitTagsPrefix,
+		GitTagSuffix:    client.Config.GitTagsSuffix,
+		GitTagsMerged:   client.Config.GitTagsMerged,
+		GitTagsProject:  client.Config.GitTagsProject,
+		GitTagsTemplate: client.Config.GitTagsTemplate,
+	})
+}
+
+// Predict predicts the next version for the configured ref, using the configured mode.
+// Returns the next version or an error if the prediction failed or is outside the version range.
+func (client Client) Predict(ctx context.Context) (result Result, err error) {
+	return core.PredictVersion(ctx, client.predictOptions())
+}
+
+// Release releases the next version on the configured ref, which is signed if configured.
+// Returns the released version or an error if the prediction or releasing failed.
+func (client Client) Release(ctx context.Context) (result Result, err error) {
+	return core.ReleaseVersion(ctx, client.predictOptions(), client.releaseOptions())
+}
+
+// ReleaseAndPush releases the next version and pushes it in one step, retrying if another release pushed the same version.
+// Returns the released version and the remotes it was pushed to or an error if the prediction, releasing or pushing failed.
+func (client Client) ReleaseAndPush(ctx context.Context) (result Result, err error) {
+	return core.ReleaseAndPushVersion(ctx, client.predictOptions(), client.releaseOptions(), client.pushOptions())
+}
+
+// Push pushes the current version to the configured remotes.
+// Returns the pushed version and the remotes it was pushed to or an error if pushing failed for any of the remotes.
+func (client Client) Push(ctx context.Context) (result Result, err error) {
+	return core.PushVersion(ctx, client.pushOptions())
+}
+
+// Update fetches the tags from the configured remote, as well as the full history of a shallow clone.
+// Returns an error if fetching the tags failed.
+func (client Client) Update(ctx context.Context) (err error) {
+	return core.UpdateVersion(ctx, &core.UpdateVersionOptions{
+		DryRun:    client.Config.DryRun,
+		GitAPI:    client.GitAPI,
+		GitRemote: client.Config.GitRemote,
+	})
+}
+
+// predictOptions creates the options to predict a version with.
+// Returns the options.
+func (client Client) predictOptions() *core.PredictVersionOptions {
+	return &core.PredictVersionOptions{
+		Branch:              client.Config.Branch,
+		Constraints:         client.Config.Constraints,
+		DefaultVersion:      client.Config.DefaultVersion,
+		GitAPI:              client.GitAPI,
+		GitBranchDelimiters: client.Config.GitBranchDelimiters,
+		GitCommitDelimiters: client.Config.GitCommitDelimiters,
+		GitTagsMerged:       client.Config.GitTagsMerged,
+		GitTagsPrefix:       client.Config.GitTagsPrefix,
+		GitTagsProject:      client.Config.GitTagsProject,
+		GitTagsSuffix:       client.Config.GitTagsSuffix,
+		GitTagsTemplate:     client.Config.GitTagsTemplate,
+		Mode:                client.Config.Mode,
+		Ref:                 client.Config.Ref,
+		SemverMap:           client.Config.SemverMap,
+	}
+}
+
+// releaseOptions creates the options to release a version with.
+// Returns the options.
+func (client Client) releaseOptions() *core.ReleaseVersionOptions {
+	return &core.ReleaseVersionOptions{
+		DryRun:               client.Config.DryRun,
+		GitTagsSign:          client.Config.GitTagsSign,
+		GitTagsSigningFormat: client.Config.GitTagsSigningFormat,
+		GitTagsSigningKey:    client.Config.GitTagsSigningKey,
+	}
+}
+
+// pushOptions creates the options to push a version with.
+// Returns the options.
+func (client Client) pushOptions() *core.PushVersionOptions {
+	return &core.PushVersionOptions{
+		DefaultVersion:  client.Config.DefaultVersion,
+		DryRun:          client.Config.DryRun,
+		GitAPI:          client.GitAPI,
+		GitPushRemotes:  client.Config.GitPushRemotes,
+		GitPushRetries:  client.Config.GitPushRetries,
+		GitRemote:       client.Config.GitRemote,
+		GitTagsMerged:   client.Config.GitTagsMerged,
+		GitTagsPrefix:   client.Config.GitTagsPrefix,
+		GitTagsProject:  client.Config.GitTagsProject,
+		GitTagsSuffix:   client.Config.GitTagsSuffix,
+		GitTagsTemplate: client.Config.GitTagsTemplate,
+		Ref:             client.Config.Ref,
+	}
+}
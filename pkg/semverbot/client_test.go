@@ -0,0 +1,216 @@
+package semverbot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/restechnica/semverbot/internal/fakes"
+	"github.com/restechnica/semverbot/pkg/modes"
+)
+
+func newPatchConfig() Config {
+	var config = DefaultConfig()
+	config.Mode = modes.Patch
+	return config
+}
+
+func TestClient_Current(t *testing.T) {
+	type Test struct {
+		Name      string
+		LocalTags []string
+		Want      Result
+	}
+
+	var tests = []Test{
+		{Name: "ReturnDefaultVersionWithoutTags", LocalTags: []string{}, Want: Result{Version: "0.0.0"}},
+		{Name: "ReturnLatestVersion", LocalTags: []string{"v1.0.0", "v1.1.0", "some-tag"}, Want: Result{Version: "1.1.0", Tag: "v1.1.0"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = fakes.NewFakeGitAPI()
+			gitAPI.LocalTags = test.LocalTags
+
+			var client = NewClient(DefaultConfig(), gitAPI)
+			var got, err = client.Current(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%v, got: "%v"`, test.Want, got)
+		})
+	}
+}
+
+func TestClient_Predict(t *testing.T) {
+	t.Run("PredictNextVersion", func(t *testing.T) {
+		var want = Result{Version: "1.0.1", Tag: "v1.0.1", PreviousVersion: "1.0.0", Level: modes.Patch, Mode: modes.Patch}
+
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0"}
+
+		var client = NewClient(newPatchConfig(), gitAPI)
+		var got, err = client.Predict(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%v, got: "%v"`, want, got)
+	})
+}
+
+func TestClient_Release(t *testing.T) {
+	t.Run("CreateTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0"}
+
+		var client = NewClient(newPatchConfig(), gitAPI)
+		var got, err = client.Release(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.1", got.Version)
+		assert.Equal(t, []string{"v1.0.0", "v1.0.1"}, gitAPI.LocalTags)
+	})
+
+	t.Run("ReleasePastRetractedLatestVersion", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.3.0", "v1.4.0", "retracted/v1.4.0"}
+
+		var config = DefaultConfig()
+		config.Mode = modes.Minor
+
+		var client = NewClient(config, gitAPI)
+
+		var current, err = client.Current(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "1.3.0", current.Version)
+
+		var got Result
+		got, err = client.Release(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.5.0", got.Version)
+		assert.Equal(t, "1.4.0", got.PreviousVersion)
+		assert.Equal(t, []string{"v1.3.0", "v1.4.0", "retracted/v1.4.0", "v1.5.0"}, gitAPI.LocalTags)
+	})
+
+	t.Run("ReleasePastUnmergedVersion", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0", "v1.0.1", "v1.0.2"}
+		gitAPI.UnmergedTags = []string{"v1.0.1", "v1.0.2"}
+
+		var config = newPatchConfig()
+		config.GitTagsMerged = true
+
+		var client = NewClient(config, gitAPI)
+		var got, err = client.Release(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.3", got.Version)
+		assert.Equal(t, "1.0.0", got.PreviousVersion)
+		assert.Equal(t, []string{"v1.0.0", "v1.0.1", "v1.0.2", "v1.0.3"}, gitAPI.LocalTags)
+	})
+
+	t.Run("SkipTagOnDryRun", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0"}
+
+		var config = newPatchConfig()
+		config.DryRun = true
+
+		var client = NewClient(config, gitAPI)
+		var got, err = client.Release(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.1", got.Version)
+		assert.Equal(t, []string{"v1.0.0"}, gitAPI.LocalTags)
+	})
+}
+
+func TestClient_Push(t *testing.T) {
+	t.Run("PushCurrentVersionToEachRemote", func(t *testing.T) {
+		var want = []string{"origin", "mirror"}
+
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0"}
+
+		var config = DefaultConfig()
+		config.GitPushRemotes = want
+
+		var client = NewClient(config, gitAPI)
+		var got, err = client.Push(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got.PushedRemotes, `want: "%s, got: "%s"`, want, got.PushedRemotes)
+		assert.Equal(t, []string{"v1.0.0", "v1.0.0"}, gitAPI.PushedTags)
+	})
+
+	t.Run("PushVersionMergedIntoRef", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0", "v1.0.1", "v1.1.0"}
+		gitAPI.RefTags = map[string][]string{"release/1.0.x": {"v1.0.0", "v1.0.1"}}
+
+		var config = newPatchConfig()
+		config.GitTagsMerged = true
+		config.Ref = "release/1.0.x"
+
+		var client = NewClient(config, gitAPI)
+		var got, err = client.Push(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.1", got.Version)
+		assert.Equal(t, []string{"v1.0.1"}, gitAPI.PushedTags)
+	})
+}
+
+func TestClient_ReleaseAndPush(t *testing.T) {
+	t.Run("ReleaseAndPushTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v1.0.0"}
+
+		var client = NewClient(newPatchConfig(), gitAPI)
+		var got, err = client.ReleaseAndPush(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"origin"}, got.PushedRemotes)
+		assert.Equal(t, []string{"v1.0.1"}, gitAPI.PushedTags)
+	})
+
+	type RetryTest struct {
+		Merged bool
+		Name   string
+	}
+
+	var retryTests = []RetryTest{
+		{Name: "RetryWithTagsOfRejectingRemote", Merged: false},
+		{Name: "RetryWithUnmergedTagsOfRejectingRemote", Merged: true},
+	}
+
+	for _, test := range retryTests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = fakes.NewFakeGitAPI()
+			gitAPI.LocalTags = []string{"v1.0.0"}
+			gitAPI.RemoteTags = map[string][]string{"upstream": {"v1.0.0", "v1.0.1"}}
+
+			var config = newPatchConfig()
+			config.GitPushRemotes = []string{"upstream", "origin"}
+			config.GitPushRetries = 1
+			config.GitTagsMerged = test.Merged
+
+			var client = NewClient(config, gitAPI)
+			var got, err = client.ReleaseAndPush(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, "1.0.2", got.Version)
+			assert.Equal(t, []string{"upstream"}, gitAPI.FetchedRemotes)
+			assert.False(t, gitAPI.Unshallowed)
+			assert.Equal(t, []string{"upstream", "origin"}, got.PushedRemotes)
+			assert.Equal(t, []string{"v1.0.2", "v1.0.2"}, gitAPI.PushedTags)
+		})
+	}
+}
+
+func TestClient_Update(t *testing.T) {
+	t.Run("FetchTags", func(t *testing.T) {
+		var client = NewClient(DefaultConfig(), fakes.NewFakeGitAPI())
+		assert.NoError(t, client.Update(context.Background()))
+	})
+}
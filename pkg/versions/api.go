@@ -1,8 +1,12 @@
 package versions
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"strings"
 
+	blangsemver "github.com/blang/semver/v4"
 	"github.com/rs/zerolog/log"
 
 	"github.com/restechnica/semverbot/pkg/git"
@@ -10,47 +14,132 @@ import (
 	"github.com/restechnica/semverbot/pkg/semver"
 )
 
+// RetractedTagPrefix the prefix of companion tags which record the retraction of the version tag they point to.
+const RetractedTagPrefix = "retracted/"
+
 // API an API to work with versions.
+// MergedRef restricts the versions to tags merged into a ref, all tags are considered if it is empty.
 type API struct {
-	Prefix string
-	Suffix string
-	GitAPI git.API
+	Template  semver.Template
+	MergedRef string
+	GitAPI    git.API
 }
 
 // NewAPI creates a new version API.
 // Returns the new API.
-func NewAPI(prefix string, suffix string) API {
-	return API{Prefix: prefix, Suffix: suffix, GitAPI: git.NewCLI()}
+func NewAPI(template semver.Template, mergedRef string, gitAPI git.API) API {
+	return API{Template: template, MergedRef: mergedRef, GitAPI: gitAPI}
 }
 
-// GetVersion gets the latest valid semver version from the git tags.
-// The tag is trimmed because git adds newlines to the underlying command.
-// Returns the current version or an error if the GitAPI failed.
-func (api API) GetVersion() (currentVersion string, err error) {
-	var tags string
+// GetVersion gets the latest valid semver version from the git tags matching the template.
+// Returns the current version or an error if the GitAPI failed or if no version was found.
+func (api API) GetVersion(ctx context.Context) (currentVersion string, err error) {
+	var tag string
 
-	if tags, err = api.GitAPI.GetTags(); err != nil {
+	if tag, err = api.GetVersionTag(ctx); err != nil {
 		return currentVersion, err
 	}
 
+	return semver.Trim(api.Template, tag)
+}
+
+// GetVersionTag gets the git tag of the latest valid semver version from the git tags matching the template.
+// Only tags merged into the merged ref are considered if it is set. Retracted versions are skipped.
+// Returns the tag of the current version or an error if the GitAPI failed or if no version was found.
+func (api API) GetVersionTag(ctx context.Context) (tag string, err error) {
+	var tags string
+
+	if tags, err = api.getTags(ctx); err != nil {
+		return tag, err
+	}
+
 	// strip all newlines
-	var versions = strings.Fields(tags)
+	var versions = skipRetracted(strings.Fields(tags))
 
-	if currentVersion, err = semver.Find(api.Prefix, api.Suffix, versions); err != nil {
-		return currentVersion, err
+	return semver.Find(api.Template, versions)
+}
+
+// GetBaseVersionTag gets the git tag of the latest valid semver version to predict the next version from.
+// Unlike GetVersionTag, retracted versions are not skipped, because their tags still exist and can not be released again.
+// Only tags merged into the merged ref are considered if it is set.
+// Returns the tag of the base version or an error if the GitAPI failed or if no version was found.
+func (api API) GetBaseVersionTag(ctx context.Context) (tag string, err error) {
+	var tags string
+
+	if tags, err = api.getTags(ctx); err != nil {
+		return tag, err
 	}
 
-	return semver.Trim(api.Prefix, api.Suffix, currentVersion)
+	// strip all newlines
+	var versions = skipRetractions(strings.Fields(tags))
+
+	return semver.Find(api.Template, versions)
+}
+
+// GetVersions gets all valid semver versions from the git tags matching the template, with the details of their tags.
+// Only tags merged into the merged ref are considered if it is set. Retracted versions are skipped.
+// Returns the versions sorted in descending order or an error if the GitAPI failed.
+func (api API) GetVersions(ctx context.Context) (versions []Version, err error) {
+	var output string
+
+	if output, err = api.GitAPI.GetTagDetails(ctx); err != nil {
+		return versions, err
+	}
+
+	var details = map[string][]string{}
+	var tags []string
+
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		// tag commit date annotation, where the annotation may contain spaces
+		var fields = strings.SplitN(line, " ", 4)
+
+		if fields[0] == "" {
+			continue
+		}
+
+		details[fields[0]] = append(fields, make([]string, 4-len(fields))...)
+		tags = append(tags, fields[0])
+	}
+
+	if api.MergedRef != "" {
+		if output, err = api.getTags(ctx); err != nil {
+			return versions, err
+		}
+
+		tags = strings.Fields(output)
+	}
+
+	versions = []Version{}
+
+	for _, tag := range semver.FindAll(api.Template, skipRetracted(tags)) {
+		// FindAll only finds tags which parse
+		var parsed, _ = api.Template.Parse(tag)
+		var fields = details[tag]
+
+		if fields == nil {
+			continue
+		}
+
+		versions = append(versions, Version{
+			Version:    parsed.String(),
+			Tag:        tag,
+			Commit:     fields[1],
+			Date:       fields[2],
+			Annotation: fields[3],
+		})
+	}
+
+	return versions, nil
 }
 
 // GetVersionOrDefault gets the current version or a default version if it failed.
 // Returns the current version or a default version.
-func (api API) GetVersionOrDefault(defaultVersion string) (version string) {
+func (api API) GetVersionOrDefault(ctx context.Context, defaultVersion string) (version string) {
 	var err error
 
 	log.Info().Msg("getting version...")
 
-	if version, err = api.GetVersion(); err != nil {
+	if version, err = api.GetVersion(ctx); err != nil {
 		log.Debug().Err(err).Msg("")
 		log.Warn().Msg("falling back to default version")
 		version = defaultVersion
@@ -62,58 +151,395 @@ func (api API) GetVersionOrDefault(defaultVersion string) (version string) {
 }
 
 // PredictVersion increments a version based on a modes.Mode.
-// Returns the next version or an error if the increment failed.
-func (api API) PredictVersion(version string, mode modes.Mode) (string, error) {
+// Returns the next version or an error if the increment failed or if the context is done,
+// since modes might have fallen back to a different semver level because of the context.
+func (api API) PredictVersion(ctx context.Context, version string, mode modes.Mode) (string, error) {
 	var err error
 
 	log.Info().Msg("predicting version...")
 
-	version, err = mode.Increment(api.Prefix, api.Suffix, version)
+	if version, err = mode.Increment(ctx, version); err == nil {
+		err = ctx.Err()
+	}
 
 	log.Info().Msg(version)
 
 	return version, err
 }
 
-// ReleaseVersion releases a version by creating an annotated git tag with a prefix.
+// ConstrainVersion constrains a predicted version to the range of the first constraint matching a branch.
+// A prediction outside the range is refused, or falls back to a patch increment of the version if the constraint clamps.
+// Returns the constrained version or an error if the version is outside the range.
+func (api API) ConstrainVersion(ctx context.Context, branch string, version string, prediction string, constraints []Constraint) (constrained string, err error) {
+	if len(constraints) == 0 {
+		return prediction, nil
+	}
+
+	var constraint Constraint
+	var found bool
+
+	if constraint, found, err = FindConstraint(branch, constraints); err != nil || !found {
+		return prediction, err
+	}
+
+	var allowed blangsemver.Range
+
+	if allowed, err = semver.ParseRange(constraint.Range); err != nil {
+		return constrained, err
+	}
+
+	var parsed blangsemver.Version
+
+	if parsed, err = semver.Parse(prediction); err != nil {
+		return constrained, err
+	}
+
+	if allowed(parsed) {
+		return prediction, nil
+	}
+
+	if !constraint.Clamp {
+		return constrained, fmt.Errorf("version %s is outside the range '%s' of branch '%s'", prediction, constraint.Range, branch)
+	}
+
+	if constrained, err = modes.NewPatchMode().Increment(ctx, version); err != nil {
+		return constrained, err
+	}
+
+	if parsed, err = semver.Parse(constrained); err != nil {
+		return constrained, err
+	}
+
+	if !allowed(parsed) {
+		return "", fmt.Errorf("version %s is outside the range '%s' of branch '%s'", constrained, constraint.Range, branch)
+	}
+
+	log.Warn().Str("branch", branch).Str("range", constraint.Range).Msgf("version %s is out of range, clamping to %s", prediction, constrained)
+
+	return constrained, nil
+}
+
+// SkipReleasedVersions increments a predicted version with a mode as long as the version is already released.
+// Only needed if the versions are restricted to a merged ref, since the next version might already be released
+// on a branch which is not merged into it. All tags are considered, including the tags of retracted versions.
+// Returns the first version which is not released yet with the released versions it skipped,
+// or an error if the GitAPI or the increment failed.
+func (api API) SkipReleasedVersions(ctx context.Context, version string, mode modes.Mode) (next string, skipped []string, err error) {
+	if api.MergedRef == "" {
+		return version, skipped, err
+	}
+
+	var tags string
+
+	if tags, err = api.GitAPI.GetTags(ctx); err != nil {
+		return version, skipped, err
+	}
+
+	var released = map[string]bool{}
+
+	for _, tag := range semver.FindAll(api.Template, skipRetractions(strings.Fields(tags))) {
+		// FindAll only finds tags which parse
+		var parsed, _ = api.Template.Parse(tag)
+		released[parsed.String()] = true
+	}
+
+	for next = version; released[next]; {
+		skipped = append(skipped, next)
+
+		if next, err = mode.Increment(ctx, next); err != nil {
+			return next, skipped, err
+		}
+	}
+
+	if len(skipped) > 0 {
+		log.Warn().Strs("skipped", skipped).Msgf("version already released on an unmerged ref, using %s", next)
+	}
+
+	return next, skipped, err
+}
+
+// ReleaseVersion releases a version by creating an annotated git tag rendered by the template on a ref, e.g. HEAD.
 // Returns an error if the tag creation failed.
-func (api API) ReleaseVersion(version string) (err error) {
+func (api API) ReleaseVersion(ctx context.Context, version string, ref string) (err error) {
 	log.Info().Msg("releasing version...")
-	var prefixedVersion = AddPrefix(version, api.Prefix)
-	var prefixedAndSuffixedVersion = AddSuffix(prefixedVersion, api.Suffix)
-	return api.GitAPI.CreateAnnotatedTag(prefixedAndSuffixedVersion)
+	var tag = api.Template.Render(version)
+	return api.GitAPI.CreateAnnotatedTag(ctx, tag, ref, tag)
 }
 
-// PushVersion pushes a version by pushing a git tag with a prefix.
-// Returns an error if pushing the tag failed.
-func (api API) PushVersion(version string) (err error) {
+// ReleaseSignedVersion releases a version by creating a signed annotated git tag rendered by the template on a ref, e.g. HEAD.
+// The default signing key and format of the git config are used if no signing key or format are given.
+// Returns an error if the tag creation or signing failed.
+func (api API) ReleaseSignedVersion(ctx context.Context, version string, ref string, signingKey string, signingFormat string) (err error) {
+	log.Info().Msg("releasing signed version...")
+	var tag = api.Template.Render(version)
+	return api.GitAPI.CreateSignedTag(ctx, tag, ref, signingKey, signingFormat)
+}
+
+// GetTag gets the existing git tag of a version, which differs from the rendered tag if the template parsed it tolerantly,
+// e.g. 1.2.3 or v1.2 for the default template. The rendered tag is preferred if it exists.
+// Companion tags count as their version tag, so the tag is still found after deleting a retracted version but not its retraction.
+// Returns the tag, the rendered tag if no tag has the version, or an error if the GitAPI failed.
+func (api API) GetTag(ctx context.Context, version string) (tag string, err error) {
+	var tags string
+
+	if tags, err = api.GitAPI.GetTags(ctx); err != nil {
+		return tag, err
+	}
+
+	var rendered = api.Template.Render(version)
+
+	for _, candidate := range strings.Fields(tags) {
+		candidate = strings.TrimPrefix(candidate, RetractedTagPrefix)
+
+		if candidate == rendered {
+			return rendered, nil
+		}
+
+		if parsed, parseErr := api.Template.Parse(candidate); parseErr == nil && tag == "" && parsed.String() == version {
+			tag = candidate
+		}
+	}
+
+	if tag == "" {
+		tag = rendered
+	}
+
+	return tag, nil
+}
+
+// DeleteVersion deletes the local git tag of a version.
+// Returns an error if the tag deletion failed.
+func (api API) DeleteVersion(ctx context.Context, version string) (err error) {
+	log.Info().Msg("deleting version...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.GitAPI.DeleteTag(ctx, tag)
+}
+
+// DeleteRemoteVersion deletes the git tag of a version on each remote.
+// A failed deletion does not prevent deleting on the other remotes.
+// Returns an error for each remote the tag could not be deleted on.
+func (api API) DeleteRemoteVersion(ctx context.Context, version string, remotes []string) (err error) {
+	log.Info().Msg("deleting remote version...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.deleteRemoteTag(ctx, tag, remotes)
+}
+
+// DeleteRetraction deletes the local companion tag which retracts a version.
+// Returns an error if the tag deletion failed.
+func (api API) DeleteRetraction(ctx context.Context, version string) (err error) {
+	log.Info().Msg("deleting retraction...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.GitAPI.DeleteTag(ctx, RetractedTagPrefix+tag)
+}
+
+// DeleteRemoteRetraction deletes the companion tag which retracts a version on each remote.
+// Returns an error for each remote the tag could not be deleted on.
+func (api API) DeleteRemoteRetraction(ctx context.Context, version string, remotes []string) (err error) {
+	log.Info().Msg("deleting remote retraction...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.deleteRemoteTag(ctx, RetractedTagPrefix+tag, remotes)
+}
+
+// IsRetracted checks whether a companion tag retracts a version.
+// Returns true if the version is retracted or an error if the GitAPI failed.
+func (api API) IsRetracted(ctx context.Context, version string) (retracted bool, err error) {
+	var tags, tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return retracted, err
+	}
+
+	if tags, err = api.GitAPI.GetTags(ctx); err != nil {
+		return retracted, err
+	}
+
+	for _, candidate := range strings.Fields(tags) {
+		if candidate == RetractedTagPrefix+tag {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// PushVersion pushes a version by pushing the git tag rendered by the template to each remote.
+// A failed push does not prevent pushing to the other remotes.
+// Returns an error for each remote the tag could not be pushed to.
+func (api API) PushVersion(ctx context.Context, version string, remotes []string) (err error) {
 	log.Info().Msg("pushing version...")
-	var prefixedVersion = AddPrefix(version, api.Prefix)
-	var prefixedAndSuffixedVersion = AddSuffix(prefixedVersion, api.Suffix)
-	return api.GitAPI.PushTag(prefixedAndSuffixedVersion)
+	return api.pushTag(ctx, api.Template.Render(version), remotes)
+}
+
+// PushRetraction pushes the companion tag which retracts a version to each remote.
+// Returns an error for each remote the tag could not be pushed to.
+func (api API) PushRetraction(ctx context.Context, version string, remotes []string) (err error) {
+	log.Info().Msg("pushing retraction...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.pushTag(ctx, RetractedTagPrefix+tag, remotes)
 }
 
-// UpdateVersion updates the version by making the git repo unshallow and by fetching all git tags.
+// RetractVersion retracts a version by creating an annotated companion tag on the git tag of the version.
+// The tag of the version is kept, but the version is skipped from then on. The reason is the message of the companion tag.
+// Returns an error if the tag creation failed.
+func (api API) RetractVersion(ctx context.Context, version string, reason string) (err error) {
+	log.Info().Msg("retracting version...")
+
+	var tag string
+
+	if tag, err = api.GetTag(ctx, version); err != nil {
+		return err
+	}
+
+	return api.GitAPI.CreateAnnotatedTag(ctx, RetractedTagPrefix+tag, tag, reason)
+}
+
+// UpdateVersion updates the version by making the git repo unshallow and by fetching all git tags from a remote.
 // Returns and error if anything went wrong. Errors from making the git repo unshallow are ignored.
-func (api API) UpdateVersion() (err error) {
+func (api API) UpdateVersion(ctx context.Context, remote string) (err error) {
 	log.Info().Msg("updating version...")
 
 	var output string
 
 	log.Info().Msg("fetching unshallow repository...")
 
-	if output, err = api.GitAPI.FetchUnshallow(); err != nil {
+	if output, err = api.GitAPI.FetchUnshallow(ctx); err != nil {
 		log.Debug().Err(err).Msg("")
 		log.Warn().Msg("ignoring failed unshallow fetch for now, repository might already be complete")
 	} else {
 		log.Debug().Msg(strings.Trim(output, "\n"))
 	}
 
+	return api.FetchVersions(ctx, remote)
+}
+
+// FetchVersions fetches all git tags from a remote, without making the git repo unshallow like UpdateVersion does.
+// Returns an error if fetching the tags failed.
+func (api API) FetchVersions(ctx context.Context, remote string) (err error) {
+	var output string
+
 	log.Info().Msg("fetching tags...")
 
-	if output, err = api.GitAPI.FetchTags(); err == nil {
+	if output, err = api.GitAPI.FetchTags(ctx, remote); err == nil {
 		log.Debug().Msg(strings.Trim(output, "\n"))
 	}
 
 	return err
 }
+
+// VerifyVersion verifies the signature of the git tag of a version.
+// Returns the verification output or an error if the signature is invalid or missing.
+func (api API) VerifyVersion(ctx context.Context, version string) (output string, err error) {
+	log.Info().Msg("verifying version...")
+	var tag = api.Template.Render(version)
+	return api.GitAPI.VerifyTag(ctx, tag)
+}
+
+// getTags gets the tags merged into the merged ref, or all tags if the merged ref is empty.
+// Returns a string of newline separated tags or an error if the GitAPI failed.
+func (api API) getTags(ctx context.Context) (tags string, err error) {
+	if api.MergedRef == "" {
+		return api.GitAPI.GetTags(ctx)
+	}
+
+	return api.GitAPI.GetMergedTags(ctx, api.MergedRef)
+}
+
+// deleteRemoteTag deletes a git tag on each remote.
+// Returns an error for each remote the tag could not be deleted on.
+func (api API) deleteRemoteTag(ctx context.Context, tag string, remotes []string) (err error) {
+	var errs []error
+
+	for _, remote := range remotes {
+		if err = api.GitAPI.DeleteRemoteTag(ctx, remote, tag); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete on remote '%s': %w", remote, err))
+			continue
+		}
+
+		log.Info().Str("remote", remote).Str("tag", tag).Msg("deleted tag")
+	}
+
+	return errors.Join(errs...)
+}
+
+// pushTag pushes a git tag to each remote.
+// Returns an error for each remote the tag could not be pushed to.
+func (api API) pushTag(ctx context.Context, tag string, remotes []string) (err error) {
+	var errs []error
+
+	for _, remote := range remotes {
+		if err = api.GitAPI.PushTag(ctx, remote, tag); err != nil {
+			errs = append(errs, fmt.Errorf("failed to push to remote '%s': %w", remote, err))
+			continue
+		}
+
+		log.Info().Str("remote", remote).Str("tag", tag).Msg("pushed tag")
+	}
+
+	return errors.Join(errs...)
+}
+
+// skipRetractions skips the companion tags which retract a version, but not the tags of the retracted versions.
+// Returns the remaining tags.
+func skipRetractions(tags []string) []string {
+	var remaining = make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, RetractedTagPrefix) {
+			remaining = append(remaining, tag)
+		}
+	}
+
+	return remaining
+}
+
+// skipRetracted skips the tags which are retracted by a companion tag, as well as the companion tags themselves.
+// Returns the remaining tags.
+func skipRetracted(tags []string) []string {
+	var retracted = map[string]bool{}
+
+	for _, tag := range tags {
+		if target, found := strings.CutPrefix(tag, RetractedTagPrefix); found {
+			retracted[target] = true
+		}
+	}
+
+	var remaining = make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		if !retracted[tag] && !strings.HasPrefix(tag, RetractedTagPrefix) {
+			remaining = append(remaining, tag)
+		}
+	}
+
+	return remaining
+}
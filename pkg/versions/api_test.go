@@ -1,24 +1,34 @@
 package versions
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/restechnica/semverbot/internal/fakes"
 	"github.com/restechnica/semverbot/internal/mocks"
 	"github.com/restechnica/semverbot/pkg/cli"
 	"github.com/restechnica/semverbot/pkg/git"
 	"github.com/restechnica/semverbot/pkg/modes"
+	"github.com/restechnica/semverbot/pkg/semver"
 )
 
 func init() {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
 }
 
+// newTemplate creates the default tag template with a prefix and suffix.
+func newTemplate(t *testing.T, prefix string, suffix string) semver.Template {
+	var template, err = semver.NewTemplate(semver.DefaultTemplate, prefix, suffix, "")
+	require.NoError(t, err)
+	return template
+}
+
 func TestAPI_GetVersion(t *testing.T) {
 	type Test struct {
 		Name    string
@@ -34,18 +44,58 @@ func TestAPI_GetVersion(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var cmder = mocks.NewMockCommander()
-			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Version, nil)
+			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Prefix+test.Version+test.Suffix, nil)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var got, err = versionAPI.GetVersion()
+			var got, err = versionAPI.GetVersion(context.Background())
 
 			assert.NoError(t, err)
 			assert.Equal(t, test.Version, got, `want: "%s, got: "%s"`, test.Version, got)
 		})
 	}
 
+	t.Run("IgnoreTagsNotMatchingTemplate", func(t *testing.T) {
+		var want = "1.0.0"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("foo-v2.0.0\nv1.1.0\nv1.0.01\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", "1"), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersion(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("SkipRetractedVersions", func(t *testing.T) {
+		var want = "1.0.0"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("retracted/v1.1.0\nv1.1.0\nv1.0.0\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersion(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnVersionMergedIntoRef", func(t *testing.T) {
+		var want = "1.0.0"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetMergedTags", "some-ref").Return("v1.0.0\nv0.9.0\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), MergedRef: "some-ref", GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersion(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+		gitAPI.AssertNotCalled(t, "GetTags")
+	})
+
 	type GitErrorTest struct {
 		Error  error
 		Name   string
@@ -63,9 +113,9 @@ func TestAPI_GetVersion(t *testing.T) {
 			cmder.On("Output", mock.Anything, mock.Anything).Return("", test.Error)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var _, got = versionAPI.GetVersion()
+			var _, got = versionAPI.GetVersion(context.Background())
 
 			assert.Error(t, got)
 			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
@@ -91,9 +141,9 @@ func TestAPI_GetVersion(t *testing.T) {
 			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Versions, nil)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var _, got = versionAPI.GetVersion()
+			var _, got = versionAPI.GetVersion(context.Background())
 
 			assert.Error(t, got)
 			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
@@ -101,6 +151,131 @@ func TestAPI_GetVersion(t *testing.T) {
 	}
 }
 
+func TestAPI_GetVersionTag(t *testing.T) {
+	t.Run("ReturnTagOfLatestVersion", func(t *testing.T) {
+		var want = "v1.1.0-rc.1"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v1.0.0\nv1.1.0-rc.1\nsome-tag\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersionTag(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorWithoutVersions", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("some-tag\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var _, err = versionAPI.GetVersionTag(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAPI_GetBaseVersionTag(t *testing.T) {
+	t.Run("ReturnTagOfRetractedLatestVersion", func(t *testing.T) {
+		var want = "v1.4.0"
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v1.3.0\nv1.4.0\nretracted/v1.4.0\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetBaseVersionTag(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorWithoutVersions", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("retracted/v1.4.0\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var _, err = versionAPI.GetBaseVersionTag(context.Background())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAPI_GetVersions(t *testing.T) {
+	var details = "v1.1.0 hash-3 2024-03-01T00:00:00+00:00 some annotation\n" +
+		"v1.0.0 hash-2 2024-02-01T00:00:00+00:00 \n" +
+		"retracted/v1.1.0 hash-3 2024-03-02T00:00:00+00:00 some reason\n" +
+		"v1.0.0-rc.1 hash-1 2024-01-01T00:00:00+00:00 \n" +
+		"some-tag hash-0 2023-01-01T00:00:00+00:00 \n"
+
+	t.Run("ReturnVersionsWithDetails", func(t *testing.T) {
+		var want = []Version{
+			{Version: "1.0.0", Tag: "v1.0.0", Commit: "hash-2", Date: "2024-02-01T00:00:00+00:00"},
+			{Version: "1.0.0-rc.1", Tag: "v1.0.0-rc.1", Commit: "hash-1", Date: "2024-01-01T00:00:00+00:00"},
+		}
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTagDetails").Return(details, nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersions(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnAnnotation", func(t *testing.T) {
+		var want = []Version{{Version: "1.1.0", Tag: "v1.1.0", Commit: "hash-3", Date: "2024-03-01T00:00:00+00:00", Annotation: "some annotation"}}
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTagDetails").Return("v1.1.0 hash-3 2024-03-01T00:00:00+00:00 some annotation\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersions(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnVersionsMergedIntoRef", func(t *testing.T) {
+		var want = []Version{{Version: "1.0.0-rc.1", Tag: "v1.0.0-rc.1", Commit: "hash-1", Date: "2024-01-01T00:00:00+00:00"}}
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTagDetails").Return(details, nil)
+		gitAPI.On("GetMergedTags", "some-ref").Return("v1.0.0-rc.1\nsome-tag\n", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), MergedRef: "some-ref", GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersions(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnNoVersionsWithoutTags", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTagDetails").Return("", nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got, err = versionAPI.GetVersions(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("ReturnErrorOnGitError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTagDetails").Return("", want)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var _, got = versionAPI.GetVersions(context.Background())
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
 func TestAPI_GetVersionOrDefault(t *testing.T) {
 	type Test struct {
 		Name    string
@@ -116,12 +291,12 @@ func TestAPI_GetVersionOrDefault(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var cmder = mocks.NewMockCommander()
-			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Version, nil)
+			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Prefix+test.Version+test.Suffix, nil)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var got, err = versionAPI.GetVersion()
+			var got, err = versionAPI.GetVersion(context.Background())
 
 			assert.NoError(t, err)
 			assert.Equal(t, test.Version, got, `want: "%s, got: "%s"`, test.Version, got)
@@ -145,9 +320,9 @@ func TestAPI_GetVersionOrDefault(t *testing.T) {
 			cmder.On("Output", mock.Anything, mock.Anything).Return("", test.Error)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var got = versionAPI.GetVersionOrDefault(cli.DefaultVersion)
+			var got = versionAPI.GetVersionOrDefault(context.Background(), cli.DefaultVersion)
 
 			assert.Equal(t, cli.DefaultVersion, got, `want: "%s, got: "%s"`, cli.DefaultVersion, got)
 		})
@@ -176,9 +351,9 @@ func TestAPI_PredictVersion(t *testing.T) {
 			cmder.On("Output", mock.Anything, mock.Anything).Return(test.Version, nil)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var got, err = versionAPI.PredictVersion(test.Version, test.Mode)
+			var got, err = versionAPI.PredictVersion(context.Background(), test.Version, test.Mode)
 
 			assert.NoError(t, err)
 			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
@@ -199,17 +374,302 @@ func TestAPI_PredictVersion(t *testing.T) {
 
 	for _, test := range errorTests {
 		t.Run(test.Name, func(t *testing.T) {
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix)}
 
 			var mode = mocks.NewMockMode()
-			mode.On("Increment", mock.Anything, mock.Anything, mock.Anything).Return(test.Version, test.Error)
+			mode.On("Increment", mock.Anything).Return(test.Version, test.Error)
 
-			var _, got = versionAPI.PredictVersion("0.0.0", mode)
+			var _, got = versionAPI.PredictVersion(context.Background(), "0.0.0", mode)
 
 			assert.Error(t, got)
 			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
 		})
 	}
+
+	t.Run("ReturnErrorIfContextIsDone", func(t *testing.T) {
+		var ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		var versionAPI = API{Template: newTemplate(t, "v", "")}
+		var _, got = versionAPI.PredictVersion(ctx, "0.0.0", modes.NewPatchMode())
+
+		assert.ErrorIs(t, got, context.Canceled)
+	})
+}
+
+func TestAPI_ConstrainVersion(t *testing.T) {
+	var constraints = []Constraint{
+		{Branch: "release/1.4.x", Clamp: true, Range: "1.4.x"},
+		{Branch: "release/*", Range: ">=1.0.0 <2.0.0"},
+	}
+
+	type Test struct {
+		Branch     string
+		Name       string
+		Prediction string
+		Version    string
+		Want       string
+	}
+
+	var tests = []Test{
+		{Name: "ReturnPredictionIfNoConstraintMatches", Branch: "main", Version: "1.4.2", Prediction: "2.0.0", Want: "2.0.0"},
+		{Name: "ReturnPredictionIfWithinRange", Branch: "release/1.x", Version: "1.4.2", Prediction: "1.5.0", Want: "1.5.0"},
+		{Name: "ClampToPatchIfOutsideRange", Branch: "release/1.4.x", Version: "1.4.2", Prediction: "1.5.0", Want: "1.4.3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var versionAPI = API{Template: newTemplate(t, "v", "")}
+			var got, err = versionAPI.ConstrainVersion(context.Background(), test.Branch, test.Version, test.Prediction, constraints)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+
+	type ErrorTest struct {
+		Branch     string
+		Name       string
+		Prediction string
+		Version    string
+	}
+
+	var errorTests = []ErrorTest{
+		{Name: "ReturnErrorIfOutsideRange", Branch: "release/1.x", Version: "1.4.2", Prediction: "2.0.0"},
+		{Name: "ReturnErrorIfClampedVersionIsOutsideRange", Branch: "release/1.4.x", Version: "1.3.0", Prediction: "2.0.0"},
+	}
+
+	for _, test := range errorTests {
+		t.Run(test.Name, func(t *testing.T) {
+			var versionAPI = API{Template: newTemplate(t, "v", "")}
+			var _, err = versionAPI.ConstrainVersion(context.Background(), test.Branch, test.Version, test.Prediction, constraints)
+
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("ReturnPredictionIfNoConstraints", func(t *testing.T) {
+		var want = "2.0.0"
+
+		var versionAPI = API{Template: newTemplate(t, "v", "")}
+		var got, err = versionAPI.ConstrainVersion(context.Background(), "release/1.4.x", "1.0.0", want, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestAPI_SkipReleasedVersions(t *testing.T) {
+	type Test struct {
+		Mode        modes.Mode
+		Name        string
+		Tags        string
+		Version     string
+		Want        string
+		WantSkipped []string
+	}
+
+	var tests = []Test{
+		{Name: "ReturnVersionIfNotReleased", Mode: modes.NewPatchMode(), Tags: "v1.0.0\n", Version: "1.0.1", Want: "1.0.1"},
+		{Name: "SkipReleasedPatchVersions", Mode: modes.NewPatchMode(), Tags: "v1.0.0\nv1.0.1\nv1.0.2\n", Version: "1.0.1", Want: "1.0.3", WantSkipped: []string{"1.0.1", "1.0.2"}},
+		{Name: "SkipReleasedMinorVersion", Mode: modes.NewMinorMode(), Tags: "v1.0.0\nv1.1.0\n", Version: "1.1.0", Want: "1.2.0", WantSkipped: []string{"1.1.0"}},
+		{Name: "SkipRetractedVersion", Mode: modes.NewPatchMode(), Tags: "v1.0.1\nretracted/v1.0.1\n", Version: "1.0.1", Want: "1.0.2", WantSkipped: []string{"1.0.1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = mocks.NewMockGitAPI()
+			gitAPI.On("GetTags").Return(test.Tags, nil)
+
+			var versionAPI = NewAPI(newTemplate(t, "v", ""), git.HEAD, gitAPI)
+			var got, skipped, err = versionAPI.SkipReleasedVersions(context.Background(), test.Version, test.Mode)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+			assert.Equal(t, test.WantSkipped, skipped)
+		})
+	}
+
+	t.Run("SkipGitIfNotMerged", func(t *testing.T) {
+		var versionAPI = NewAPI(newTemplate(t, "v", ""), "", nil)
+		var got, skipped, err = versionAPI.SkipReleasedVersions(context.Background(), "1.0.1", modes.NewPatchMode())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0.1", got)
+		assert.Empty(t, skipped)
+	})
+}
+
+func TestAPI_DeleteVersion(t *testing.T) {
+	t.Run("DeleteTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v0.0.1", "v0.0.2"}
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.DeleteVersion(context.Background(), "0.0.2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"v0.0.1"}, gitAPI.LocalTags, `want: "%s, got: "%s"`, []string{"v0.0.1"}, gitAPI.LocalTags)
+	})
+
+	t.Run("DeleteTolerantlyParsedTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v0.0.1", "0.0.2"}
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.DeleteVersion(context.Background(), "0.0.2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"v0.0.1"}, gitAPI.LocalTags, `want: "%s, got: "%s"`, []string{"v0.0.1"}, gitAPI.LocalTags)
+	})
+
+	t.Run("ReturnErrorOnGitApiError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v0.0.1\n", nil)
+		gitAPI.On("DeleteTag", "v0.0.1").Return(want)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got = versionAPI.DeleteVersion(context.Background(), "0.0.1")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
+func TestAPI_DeleteRemoteVersion(t *testing.T) {
+	t.Run("DeleteTagOnEachRemote", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.PushedRemotes = []string{"origin", "mirror"}
+		gitAPI.PushedTags = []string{"v0.0.1", "v0.0.1"}
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.DeleteRemoteVersion(context.Background(), "0.0.1", []string{"origin", "mirror"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, gitAPI.PushedTags)
+	})
+
+	t.Run("ReturnErrorForEachFailedRemote", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v0.0.1\n", nil)
+		gitAPI.On("DeleteRemoteTag", "origin", "v0.0.1").Return(fmt.Errorf("some-error"))
+		gitAPI.On("DeleteRemoteTag", "mirror", "v0.0.1").Return(nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var got = versionAPI.DeleteRemoteVersion(context.Background(), "0.0.1", []string{"origin", "mirror"})
+
+		assert.ErrorContains(t, got, "origin")
+		assert.NotContains(t, got.Error(), "mirror")
+		gitAPI.AssertExpectations(t)
+	})
+}
+
+func TestAPI_GetTag(t *testing.T) {
+	type Test struct {
+		Name    string
+		Tags    string
+		Version string
+		Want    string
+	}
+
+	var tests = []Test{
+		{Name: "ReturnRenderedTag", Tags: "v1.0.0\n", Version: "1.0.0", Want: "v1.0.0"},
+		{Name: "ReturnBareTag", Tags: "1.2.3\n", Version: "1.2.3", Want: "1.2.3"},
+		{Name: "ReturnPartialTag", Tags: "v1.2\n", Version: "1.2.0", Want: "v1.2"},
+		{Name: "PreferRenderedTag", Tags: "1.2.3\nv1.2.3\n", Version: "1.2.3", Want: "v1.2.3"},
+		{Name: "ReturnTagOfRetraction", Tags: "retracted/v1.2\n", Version: "1.2.0", Want: "v1.2"},
+		{Name: "ReturnRenderedTagIfNoTagHasVersion", Tags: "v1.0.0\n", Version: "2.0.0", Want: "v2.0.0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = mocks.NewMockGitAPI()
+			gitAPI.On("GetTags").Return(test.Tags, nil)
+
+			var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+			var got, err = versionAPI.GetTag(context.Background(), test.Version)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%s, got: "%s"`, test.Want, got)
+		})
+	}
+}
+
+func TestAPI_RetractVersion(t *testing.T) {
+	t.Run("CreateCompanionTag", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v0.0.1\n", nil)
+		gitAPI.On("CreateAnnotatedTag", "retracted/v0.0.1", "v0.0.1", "broken build").Return(nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.RetractVersion(context.Background(), "0.0.1", "broken build")
+
+		assert.NoError(t, err)
+		gitAPI.AssertExpectations(t)
+	})
+
+	t.Run("CreateCompanionTagOnTolerantlyParsedTag", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("GetTags").Return("v1.2\n", nil)
+		gitAPI.On("CreateAnnotatedTag", "retracted/v1.2", "v1.2", "broken build").Return(nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.RetractVersion(context.Background(), "1.2.0", "broken build")
+
+		assert.NoError(t, err)
+		gitAPI.AssertExpectations(t)
+	})
+
+	t.Run("PushCompanionTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.PushRetraction(context.Background(), "0.0.1", []string{"origin"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"retracted/v0.0.1"}, gitAPI.PushedTags)
+	})
+
+	t.Run("DeleteCompanionTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		gitAPI.LocalTags = []string{"v0.0.1", "retracted/v0.0.1"}
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+		var err = versionAPI.DeleteRetraction(context.Background(), "0.0.1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"v0.0.1"}, gitAPI.LocalTags)
+	})
+}
+
+func TestAPI_IsRetracted(t *testing.T) {
+	type Test struct {
+		Name    string
+		Tags    string
+		Version string
+		Want    bool
+	}
+
+	var tests = []Test{
+		{Name: "ReturnTrueIfRetracted", Tags: "retracted/v0.0.1\nv0.0.1\n", Version: "0.0.1", Want: true},
+		{Name: "ReturnFalseIfNotRetracted", Tags: "retracted/v0.0.1\nv0.0.1\nv0.0.2\n", Version: "0.0.2", Want: false},
+		{Name: "ReturnTrueIfTolerantlyParsedTagRetracted", Tags: "retracted/1.2.3\n1.2.3\n", Version: "1.2.3", Want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var gitAPI = mocks.NewMockGitAPI()
+			gitAPI.On("GetTags").Return(test.Tags, nil)
+
+			var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+			var got, err = versionAPI.IsRetracted(context.Background(), test.Version)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Want, got, `want: "%t, got: "%t"`, test.Want, got)
+		})
+	}
 }
 
 func TestAPI_PushVersion(t *testing.T) {
@@ -233,9 +693,9 @@ func TestAPI_PushVersion(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var gitAPI = fakes.NewFakeGitAPI()
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var err = versionAPI.PushVersion(test.Version)
+			var err = versionAPI.PushVersion(context.Background(), test.Version, []string{"origin"})
 
 			var pushedTags = versionAPI.GitAPI.(*fakes.FakeGitAPI).PushedTags
 			var got = pushedTags[len(pushedTags)-1]
@@ -245,6 +705,34 @@ func TestAPI_PushVersion(t *testing.T) {
 		})
 	}
 
+	t.Run("PushToEachRemote", func(t *testing.T) {
+		var want = []string{"origin", "upstream"}
+
+		var gitAPI = fakes.NewFakeGitAPI()
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		var err = versionAPI.PushVersion(context.Background(), "0.0.1", want)
+		var got = gitAPI.PushedRemotes
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("PushToRemainingRemotesOnGitApiError", func(t *testing.T) {
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("PushTag", "mirror", "v0.0.1").Return(fmt.Errorf("some-error"))
+		gitAPI.On("PushTag", "upstream", "v0.0.1").Return(nil)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		var got = versionAPI.PushVersion(context.Background(), "0.0.1", []string{"mirror", "upstream"})
+
+		assert.Error(t, got)
+		assert.Contains(t, got.Error(), "mirror")
+		assert.NotContains(t, got.Error(), "upstream")
+		gitAPI.AssertExpectations(t)
+	})
+
 	type ErrorTest struct {
 		Error   error
 		Name    string
@@ -261,12 +749,12 @@ func TestAPI_PushVersion(t *testing.T) {
 			cmder.On("Run", mock.Anything, mock.Anything).Return(test.Error)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: "v", Suffix: "", GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
 
-			var got = versionAPI.PushVersion("0.0.1")
+			var got = versionAPI.PushVersion(context.Background(), "0.0.1", []string{"origin"})
 
 			assert.Error(t, got)
-			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
+			assert.ErrorIs(t, got, test.Error)
 		})
 	}
 }
@@ -292,9 +780,9 @@ func TestAPI_ReleaseVersion(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var gitAPI = fakes.NewFakeGitAPI()
-			var versionAPI = API{Prefix: test.Prefix, Suffix: test.Suffix, GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, test.Prefix, test.Suffix), GitAPI: gitAPI}
 
-			var err = versionAPI.ReleaseVersion(test.Version)
+			var err = versionAPI.ReleaseVersion(context.Background(), test.Version, git.HEAD)
 
 			var localTags = versionAPI.GitAPI.(*fakes.FakeGitAPI).LocalTags
 			var got = localTags[len(localTags)-1]
@@ -320,9 +808,9 @@ func TestAPI_ReleaseVersion(t *testing.T) {
 			cmder.On("Run", mock.Anything, mock.Anything).Return(test.Error)
 
 			var gitAPI = git.CLI{Commander: cmder}
-			var versionAPI = API{Prefix: "v", Suffix: "", GitAPI: gitAPI}
+			var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
 
-			var got = versionAPI.ReleaseVersion("0.0.1")
+			var got = versionAPI.ReleaseVersion(context.Background(), "0.0.1", git.HEAD)
 
 			assert.Error(t, got)
 			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
@@ -330,12 +818,41 @@ func TestAPI_ReleaseVersion(t *testing.T) {
 	}
 }
 
+func TestAPI_ReleaseSignedVersion(t *testing.T) {
+	t.Run("ReleaseSignedTag", func(t *testing.T) {
+		var want = "v0.0.1"
+
+		var gitAPI = fakes.NewFakeGitAPI()
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		var err = versionAPI.ReleaseSignedVersion(context.Background(), "0.0.1", git.HEAD, "", "")
+		var got = gitAPI.SignedTags[len(gitAPI.SignedTags)-1]
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+
+	t.Run("ReturnErrorOnGitApiError", func(t *testing.T) {
+		var want = fmt.Errorf("some-error")
+
+		var gitAPI = mocks.NewMockGitAPI()
+		gitAPI.On("CreateSignedTag", "v0.0.1", "some-ref", "key", "ssh").Return(want)
+
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		var got = versionAPI.ReleaseSignedVersion(context.Background(), "0.0.1", "some-ref", "key", "ssh")
+
+		assert.Error(t, got)
+		assert.Equal(t, want, got, `want: "%s, got: "%s"`, want, got)
+	})
+}
+
 func TestAPI_UpdateVersion(t *testing.T) {
 	t.Run("HappyPath", func(t *testing.T) {
 		var gitAPI = fakes.NewFakeGitAPI()
 		var versionAPI = API{GitAPI: gitAPI}
 
-		var err = versionAPI.UpdateVersion()
+		var err = versionAPI.UpdateVersion(context.Background(), "origin")
 
 		assert.NoError(t, err)
 	})
@@ -358,7 +875,7 @@ func TestAPI_UpdateVersion(t *testing.T) {
 			var gitAPI = git.CLI{Commander: cmder}
 			var versionAPI = API{GitAPI: gitAPI}
 
-			var got = versionAPI.UpdateVersion()
+			var got = versionAPI.UpdateVersion(context.Background(), "origin")
 
 			assert.Error(t, got)
 			assert.Equal(t, test.Error, got, `want: "%s, got: "%s"`, test.Error, got)
@@ -366,9 +883,33 @@ func TestAPI_UpdateVersion(t *testing.T) {
 	}
 }
 
+func TestAPI_VerifyVersion(t *testing.T) {
+	t.Run("VerifySignedTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		assert.NoError(t, versionAPI.ReleaseSignedVersion(context.Background(), "0.0.1", git.HEAD, "", ""))
+
+		var _, err = versionAPI.VerifyVersion(context.Background(), "0.0.1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ReturnErrorOnUnsignedTag", func(t *testing.T) {
+		var gitAPI = fakes.NewFakeGitAPI()
+		var versionAPI = API{Template: newTemplate(t, "v", ""), GitAPI: gitAPI}
+
+		assert.NoError(t, versionAPI.ReleaseVersion(context.Background(), "0.0.1", git.HEAD))
+
+		var _, err = versionAPI.VerifyVersion(context.Background(), "0.0.1")
+
+		assert.Error(t, err)
+	})
+}
+
 func TestNewAPI(t *testing.T) {
 	t.Run("ValidateState", func(t *testing.T) {
-		var api = NewAPI("v", "")
+		var api = NewAPI(newTemplate(t, "v", ""), git.HEAD, git.NewCLI(""))
 		assert.NotNil(t, api.GitAPI)
 	})
 }
@@ -0,0 +1,26 @@
+package versions
+
+import (
+	"path"
+)
+
+// Constraint binds a branch pattern to a range of versions which may be released on matching branches.
+// The pattern supports the syntax of path.Match, e.g. "release/1.x" or "release/*".
+// A version outside the range is refused, unless Clamp is enabled to fall back to a patch increment.
+type Constraint struct {
+	Branch string
+	Clamp  bool
+	Range  string
+}
+
+// FindConstraint finds the first constraint whose branch pattern matches a branch.
+// Returns the matching constraint, whether a constraint was found or an error if a branch pattern is invalid.
+func FindConstraint(branch string, constraints []Constraint) (constraint Constraint, found bool, err error) {
+	for _, constraint = range constraints {
+		if found, err = path.Match(constraint.Branch, branch); err != nil || found {
+			return constraint, found, err
+		}
+	}
+
+	return Constraint{}, false, nil
+}
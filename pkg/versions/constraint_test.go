@@ -0,0 +1,42 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConstraint(t *testing.T) {
+	var constraints = []Constraint{
+		{Branch: "release/1.4.x", Range: "1.4.x"},
+		{Branch: "release/*", Range: ">=1.0.0 <2.0.0"},
+	}
+
+	type Test struct {
+		Branch    string
+		Name      string
+		WantFound bool
+		WantRange string
+	}
+
+	var tests = []Test{
+		{Name: "FindExactMatch", Branch: "release/1.4.x", WantFound: true, WantRange: "1.4.x"},
+		{Name: "FindPatternMatch", Branch: "release/1.x", WantFound: true, WantRange: ">=1.0.0 <2.0.0"},
+		{Name: "FindNothingIfNoMatch", Branch: "main", WantFound: false, WantRange: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var got, found, err = FindConstraint(test.Branch, constraints)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.WantFound, found, `want: "%t", got: "%t"`, test.WantFound, found)
+			assert.Equal(t, test.WantRange, got.Range, `want: "%s", got: "%s"`, test.WantRange, got.Range)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidPattern", func(t *testing.T) {
+		var _, _, err = FindConstraint("main", []Constraint{{Branch: "[", Range: "1.x"}})
+		assert.Error(t, err)
+	})
+}
@@ -1,7 +0,0 @@
-package versions
-
-import "fmt"
-
-func AddPrefix(version string, prefix string) string {
-	return fmt.Sprintf("%s%s", prefix, version)
-}
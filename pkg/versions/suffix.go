@@ -1,7 +0,0 @@
-package versions
-
-import "fmt"
-
-func AddSuffix(version string, suffix string) string {
-	return fmt.Sprintf("%s%s", version, suffix)
-}
@@ -0,0 +1,56 @@
+package versions
+
+import (
+	blangsemver "github.com/blang/semver/v4"
+
+	"github.com/restechnica/semverbot/pkg/semver"
+)
+
+// Version a released version with the details of its git tag.
+// The date is in strict ISO 8601 format, the annotation is empty for lightweight tags.
+type Version struct {
+	Version    string `json:"version"`
+	Tag        string `json:"tag"`
+	Commit     string `json:"commit"`
+	Date       string `json:"date"`
+	Annotation string `json:"annotation"`
+}
+
+// FilterVersions filters versions by a range, e.g. ">=1.2 <2", and limits the number of versions.
+// Prereleases are skipped unless enabled. All versions are in range if the range is empty, a limit of 0 means no limit.
+// Returns the filtered versions in their initial order or an error if the range is invalid.
+func FilterVersions(versions []Version, constraint string, prereleases bool, limit int) (filtered []Version, err error) {
+	var allowed blangsemver.Range
+
+	if constraint != "" {
+		if allowed, err = semver.ParseRange(constraint); err != nil {
+			return filtered, err
+		}
+	}
+
+	filtered = []Version{}
+
+	for _, version := range versions {
+		if limit > 0 && len(filtered) == limit {
+			break
+		}
+
+		var parsed blangsemver.Version
+
+		if parsed, err = semver.Parse(version.Version); err != nil {
+			return filtered, err
+		}
+
+		if len(parsed.Pre) > 0 && !prereleases {
+			continue
+		}
+
+		if allowed != nil && !allowed(parsed) {
+			continue
+		}
+
+		filtered = append(filtered, version)
+	}
+
+	return filtered, nil
+}
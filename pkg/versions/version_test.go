@@ -0,0 +1,54 @@
+package versions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterVersions(t *testing.T) {
+	var versions = []Version{
+		{Version: "2.0.0"},
+		{Version: "1.3.0"},
+		{Version: "1.3.0-rc.1"},
+		{Version: "1.2.0"},
+		{Version: "1.1.0"},
+	}
+
+	type Test struct {
+		Name        string
+		Constraint  string
+		Prereleases bool
+		Limit       int
+		Want        []string
+	}
+
+	var tests = []Test{
+		{Name: "SkipPrereleases", Want: []string{"2.0.0", "1.3.0", "1.2.0", "1.1.0"}},
+		{Name: "KeepPrereleases", Prereleases: true, Want: []string{"2.0.0", "1.3.0", "1.3.0-rc.1", "1.2.0", "1.1.0"}},
+		{Name: "FilterByConstraint", Constraint: ">=1.2 <2", Want: []string{"1.3.0", "1.2.0"}},
+		{Name: "LimitVersions", Limit: 2, Want: []string{"2.0.0", "1.3.0"}},
+		{Name: "LimitFilteredVersions", Constraint: "<2", Prereleases: true, Limit: 2, Want: []string{"1.3.0", "1.3.0-rc.1"}},
+		{Name: "ReturnNothingOutsideConstraint", Constraint: ">=3", Want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var filtered, err = FilterVersions(versions, test.Constraint, test.Prereleases, test.Limit)
+			assert.NoError(t, err)
+
+			var got = []string{}
+
+			for _, version := range filtered {
+				got = append(got, version.Version)
+			}
+
+			assert.Equal(t, test.Want, got, `want: "%s", got: "%s"`, test.Want, got)
+		})
+	}
+
+	t.Run("ReturnErrorOnInvalidConstraint", func(t *testing.T) {
+		var _, err = FilterVersions(versions, "invalid", false, 0)
+		assert.Error(t, err)
+	})
+}